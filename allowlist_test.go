@@ -0,0 +1,82 @@
+package sqids
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAllowlist(t *testing.T) {
+	numbers := []uint64{1, 2, 3}
+
+	for _, o := range []Options{
+		{Blocklist: []string{"86Rf07"}, Allowlist: []string{"86Rf07"}},
+		{Blocklist: []string{"86Rf07"}, AllowlistNumbers: [][]uint64{{4, 5}, numbers}},
+	} {
+		s, err := New(o)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		generatedID, err := s.Encode(numbers)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if generatedID != "86Rf07" {
+			t.Errorf("Encoding `%v` should produce the allowed `86Rf07`, but instead produced `%v`", numbers, generatedID)
+		}
+
+		if s.isBlockedID("86Rf07") {
+			t.Errorf("86Rf07 should not be blocked")
+		}
+
+		in, err := s.Inspect("86Rf07")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !in.IsCanonical() {
+			t.Errorf("86Rf07 should be canonical, but re-encodes to %q", in.Canonical)
+		}
+
+		decodedNumbers := s.Decode(generatedID)
+		if !reflect.DeepEqual(numbers, decodedNumbers) {
+			t.Errorf("Decoding `%v` should produce `%v`, but instead produced `%v`", generatedID, numbers, decodedNumbers)
+		}
+	}
+}
+
+func TestAllowlistOnlyListed(t *testing.T) {
+	s, err := New(Options{
+		Blocklist:        []string{"86Rf07", "xxxx"},
+		Allowlist:        []string{"se8ojk"},
+		AllowlistNumbers: [][]uint64{{4, 5}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if generatedID, err := s.Encode([]uint64{1, 2, 3}); err != nil || generatedID != "se8ojk" {
+		t.Errorf("Encoding `[1 2 3]` should produce `se8ojk`, but instead produced `%v` (%v)", generatedID, err)
+	}
+
+	if word, _, ok := s.BlockedBy("86Rf07"); !ok || word != "86rf07" {
+		t.Errorf("86Rf07 should still be blocked")
+	}
+}
+
+func TestAllowlistFingerprint(t *testing.T) {
+	a, err := New(Options{Blocklist: []string{"86Rf07"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := New(Options{Blocklist: []string{"86Rf07"}, Allowlist: []string{"86Rf07"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.Equal(b) {
+		t.Errorf("an allowlist should change the fingerprint")
+	}
+}
@@ -1,7 +1,9 @@
 package sqids
 
 import (
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -10,7 +12,7 @@ func TestMultibyteAlphabet(t *testing.T) {
 		Alphabet: "ë1092",
 	})
 
-	if err != errAlphabetMultibyte {
+	if !errors.Is(err, ErrAlphabetMultibyte) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
@@ -99,3 +101,32 @@ func TestTooShortOfAnAlphabet(t *testing.T) {
 		t.Errorf("Should not accept too short of an alphabet")
 	}
 }
+
+func TestValidate(t *testing.T) {
+	if err := (Options{}).Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err := Options{
+		Alphabet:    "ëaa",
+		FixedLength: true,
+	}.Validate()
+
+	for _, want := range []error{ErrAlphabetMultibyte, ErrAlphabetNotUniqueChars, ErrFixedLengthWithoutMinLength} {
+		if !errors.Is(err, want) {
+			t.Errorf("Validate() = %v, should report %v", err, want)
+		}
+	}
+
+	if errors.Is(err, ErrAlphabetTooShort) {
+		t.Errorf("Validate() = %v, should not report %v", err, ErrAlphabetTooShort)
+	}
+
+	if !strings.Contains(err.Error(), `"a"`) {
+		t.Errorf("Validate() = %v, should report the duplicated characters", err)
+	}
+
+	if _, err := New(Options{Alphabet: "ab"}); !errors.Is(err, ErrAlphabetTooShort) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
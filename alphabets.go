@@ -0,0 +1,18 @@
+package sqids
+
+// Alphabet presets, to be used as Options.Alphabet
+const (
+	// AlphabetCrockford is Crockford's base32, without I, L, O and U
+	AlphabetCrockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+	// AlphabetNumeric is digits only, for codes sent by SMS or typed on a keypad
+	AlphabetNumeric = "0123456789"
+
+	// AlphabetLowerAlnum is lowercase letters and digits, for subdomains and
+	// other places where case is lost, see Options.CaseInsensitive
+	AlphabetLowerAlnum = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+	// AlphabetUnambiguous is letters and digits without the lookalikes
+	// 0, O, o, 1, l and I, for printed codes
+	AlphabetUnambiguous = "abcdefghijkmnpqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
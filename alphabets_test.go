@@ -0,0 +1,98 @@
+package sqids
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var alphabetPresets = map[string]string{
+	"AlphabetCrockford":   AlphabetCrockford,
+	"AlphabetNumeric":     AlphabetNumeric,
+	"AlphabetLowerAlnum":  AlphabetLowerAlnum,
+	"AlphabetUnambiguous": AlphabetUnambiguous,
+}
+
+func TestAlphabetPresets(t *testing.T) {
+	for name, alphabet := range alphabetPresets {
+		o, err := validatedOptions(Options{Alphabet: alphabet})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		lower := strings.ToLower(alphabet)
+
+		for _, word := range o.Blocklist {
+			if strings.Trim(word, lower) != "" {
+				t.Errorf("%s: blocklist word %q is not in the alphabet", name, word)
+			}
+		}
+
+		var want []string
+		for _, word := range defaultBlocklist {
+			if strings.Trim(word, lower) == "" {
+				want = append(want, word)
+			}
+		}
+
+		if len(o.Blocklist) != len(want) {
+			t.Errorf("%s: filtered blocklist has %d words, want %d", name, len(o.Blocklist), len(want))
+		}
+
+		s, err := New(Options{Alphabet: alphabet})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		numbers := []uint64{1, 2, 3}
+
+		generatedID, err := s.Encode(numbers)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		decodedNumbers := s.Decode(generatedID)
+		if !reflect.DeepEqual(numbers, decodedNumbers) {
+			t.Errorf("%s: decoding `%v` should produce `%v`, but instead produced `%v`", name, generatedID, numbers, decodedNumbers)
+		}
+	}
+}
+
+func TestAlphabetPresetBlocklists(t *testing.T) {
+	numeric, err := validatedOptions(Options{Alphabet: AlphabetNumeric})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(numeric.Blocklist) != 0 {
+		t.Errorf("numeric blocklist should be empty, has %v", numeric.Blocklist)
+	}
+
+	crockford, err := validatedOptions(Options{Alphabet: AlphabetCrockford})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, word := range crockford.Blocklist {
+		if strings.ContainsAny(word, "ilou") {
+			t.Errorf("crockford blocklist should not contain %q", word)
+		}
+	}
+
+	lowerAlnum, err := validatedOptions(Options{Alphabet: AlphabetLowerAlnum})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(lowerAlnum.Blocklist, defaultBlocklist) {
+		t.Errorf("lowercase alphanumeric blocklist should be the default blocklist")
+	}
+}
+
+func TestAlphabetPresetsCaseInsensitive(t *testing.T) {
+	for _, alphabet := range []string{AlphabetCrockford, AlphabetNumeric, AlphabetLowerAlnum} {
+		if _, err := New(Options{Alphabet: alphabet, CaseInsensitive: true}); err != nil {
+			t.Errorf("%q should be valid case-insensitive: %v", alphabet, err)
+		}
+	}
+}
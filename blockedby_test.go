@@ -0,0 +1,54 @@
+package sqids
+
+import "testing"
+
+func TestBlockedBy(t *testing.T) {
+	s, err := New(Options{
+		Blocklist: []string{"abc", "w0rd", "word", "zzzzz"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		id   string
+		word string
+		rule string
+		ok   bool
+	}{
+		{"abc", "abc", BlockRuleExact, true},
+		{"ABC", "abc", BlockRuleExact, true},
+		{"xabcx", "", "", false},
+		{"w0rdxx", "w0rd", BlockRulePrefix, true},
+		{"xxW0rd", "w0rd", BlockRuleSuffix, true},
+		{"xw0rdx", "", "", false},
+		{"xwordx", "word", BlockRuleSubstring, true},
+		{"zzzz", "", "", false},
+		{"86Rf07", "", "", false},
+	} {
+		word, rule, ok := s.BlockedBy(tt.id)
+
+		if word != tt.word || rule != tt.rule || ok != tt.ok {
+			t.Errorf("BlockedBy(%q) = %q, %q, %v, want %q, %q, %v", tt.id, word, rule, ok, tt.word, tt.rule, tt.ok)
+		}
+
+		if got := s.isBlockedID(tt.id); got != tt.ok {
+			t.Errorf("isBlockedID(%q) = %v, want %v", tt.id, got, tt.ok)
+		}
+	}
+}
+
+func TestBlockedByDefaultBlocklist(t *testing.T) {
+	s, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, ok := s.BlockedBy("86Rf07"); ok {
+		t.Errorf("86Rf07 should not be blocked")
+	}
+
+	if word, rule, ok := s.BlockedBy("xxFuckxx"); !ok || word != "fuck" || rule != BlockRuleSubstring {
+		t.Errorf("BlockedBy(xxFuckxx) = %q, %q, %v", word, rule, ok)
+	}
+}
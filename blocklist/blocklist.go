@@ -0,0 +1,126 @@
+// Package blocklist provides the words blocked by default by Sqids,
+// split into packs by language.
+//
+// Packs are selected by language code:
+//
+//	s, _ := sqids.New(sqids.Options{
+//		Blocklist: blocklist.Must(blocklist.Languages("en", "de")),
+//	})
+package blocklist
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"sort"
+	"sync"
+)
+
+// Pack errors
+var (
+	ErrUnknownLanguage = errors.New("unknown blocklist language")
+	ErrLanguageExists  = errors.New("blocklist language already registered")
+)
+
+var (
+	mu sync.RWMutex
+
+	// builtin are the packs that make up the default blocklist
+	builtin = map[string][]string{
+		"de": german,
+		"en": english,
+		"es": spanish,
+		"fr": french,
+		"hi": hindi,
+		"it": italian,
+		"pt": portuguese,
+	}
+
+	// packs are the builtin packs and the registered packs
+	packs = maps.Clone(builtin)
+)
+
+// Default returns the union of the builtin packs, which is the
+// default blocklist of Sqids
+func Default() []string {
+	codes := make([]string, 0, len(builtin))
+	for code := range builtin {
+		codes = append(codes, code)
+	}
+
+	return union(builtin, codes)
+}
+
+// Languages returns the union of the packs for the given language codes
+func Languages(codes ...string) ([]string, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	for _, code := range codes {
+		if _, ok := packs[code]; !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownLanguage, code)
+		}
+	}
+
+	return union(packs, codes), nil
+}
+
+// Codes returns the language codes of all packs, sorted
+func Codes() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	codes := make([]string, 0, len(packs))
+	for code := range packs {
+		codes = append(codes, code)
+	}
+
+	sort.Strings(codes)
+
+	return codes
+}
+
+// Register adds a pack of words for a language code, so it can be
+// selected with Languages. The builtin packs can not be replaced.
+func Register(code string, words []string) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, ok := packs[code]; ok {
+		return fmt.Errorf("%w: %q", ErrLanguageExists, code)
+	}
+
+	packs[code] = append([]string{}, words...)
+
+	return nil
+}
+
+// Must returns the words, and panics if err is not nil
+func Must(words []string, err error) []string {
+	if err != nil {
+		panic(err)
+	}
+
+	return words
+}
+
+// union returns the sorted words of the packs without duplicates
+func union(packs map[string][]string, codes []string) []string {
+	var (
+		words = []string{}
+		seen  = make(map[string]bool)
+	)
+
+	for _, code := range codes {
+		for _, word := range packs[code] {
+			if !seen[word] {
+				seen[word] = true
+				words = append(words, word)
+			}
+		}
+	}
+
+	sort.Strings(words)
+
+	return words
+}
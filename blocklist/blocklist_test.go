@@ -0,0 +1,80 @@
+package blocklist
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestDefault(t *testing.T) {
+	words := Default()
+
+	if !sort.StringsAreSorted(words) {
+		t.Errorf("default blocklist should be sorted")
+	}
+
+	if got, want := len(words), 560; got != want {
+		t.Errorf("default blocklist has %d words, want %d", got, want)
+	}
+
+	all, err := Languages("de", "en", "es", "fr", "hi", "it", "pt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(all, words) {
+		t.Errorf("default blocklist should be the union of all packs")
+	}
+}
+
+func TestLanguages(t *testing.T) {
+	words, err := Languages("en", "de")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the 8 variants of "idiot" are in both packs
+	if got, want := len(words), len(english)+len(german)-8; got != want {
+		t.Errorf("Languages(en, de) has %d words, want %d", got, want)
+	}
+
+	if _, err := Languages("en", "xx"); !errors.Is(err, ErrUnknownLanguage) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+// registerRuns numbers the runs of TestRegister, since a language can be
+// registered once per process and tests may run more than once with -count
+var registerRuns int
+
+func TestRegister(t *testing.T) {
+	if err := Register("en", []string{"foo"}); !errors.Is(err, ErrLanguageExists) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	registerRuns++
+	code := fmt.Sprintf("x-test-%d", registerRuns)
+
+	if err := Register(code, []string{"foo", "bar"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Register(code, []string{"baz"}); !errors.Is(err, ErrLanguageExists) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	words, err := Languages(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(words, []string{"bar", "foo"}) {
+		t.Errorf("Languages(%s) = %v, want [bar foo]", code, words)
+	}
+
+	if got, want := len(Default()), 560; got != want {
+		t.Errorf("registered packs should not change the default blocklist, has %d words", got)
+	}
+}
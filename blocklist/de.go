@@ -0,0 +1,10 @@
+package blocklist
+
+// german is the German blocklist pack
+var german = []string{
+	"1d10t", "1d1ot", "1di0t", "1diot", "arsch", "b1tte", "bitte", "de1ch", "deich", "depp", "f0tze",
+	"f1cker", "ficker", "fotze", "hund1n", "hundin", "id10t", "id1ot", "idi0t", "idiot", "m1st", "mist",
+	"musch1", "muschi", "neger", "saugnapf", "sch1ampe", "sche1se", "sche1sse", "scheise", "scheisse",
+	"schlampe", "schwachs1nn1g", "schwachs1nnig", "schwachsinn1g", "schwachsinnig", "schwanz",
+	"verdammt", "w1chsen", "wichsen",
+}
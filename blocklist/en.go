@@ -0,0 +1,16 @@
+package blocklist
+
+// english is the English blocklist pack
+var english = []string{
+	"0rgasm", "1d10t", "1d1ot", "1di0t", "1diot", "1mbec11e", "1mbec1le", "1mbeci1e", "1mbecile",
+	"ah01e", "ah0le", "aho1e", "ahole", "ana1", "anal", "anus", "arse", "ass", "b00b", "b00be", "b0ob",
+	"b0obe", "b1tch", "bitch", "bo0b", "bo0be", "boob", "boobe", "c0ck", "c11t", "c1it", "ch1nk",
+	"chink", "cl1t", "clit", "cock", "cracker", "crap", "cum", "cunt", "d11d0", "d11do", "d1ck",
+	"d1ld0", "d1ldo", "damn", "di1d0", "di1do", "dick", "dild0", "dildo", "dyke", "enema", "fag",
+	"fuck", "g00", "g0o", "go0", "goo", "id10t", "id1ot", "idi0t", "idiot", "imbec11e", "imbec1le",
+	"imbeci1e", "imbecile", "j1zz", "jerk", "jizz", "k1ke", "kike", "masturbat10n", "masturbat1on",
+	"masturbate", "masturbati0n", "masturbation", "n1gger", "nigger", "orgasm", "p00p", "p0op", "p0rn",
+	"pen1s", "penis", "po0p", "poop", "porn", "pr1ck", "prick", "pussy", "rape", "retard", "s1ut",
+	"sexy", "sh1t", "shit", "slut", "stup1d", "stupid", "sucker", "test1c1e", "test1cle", "testic1e",
+	"testicle", "turd", "twat", "vag1na", "vagina", "wank",
+}
@@ -0,0 +1,12 @@
+package blocklist
+
+// spanish is the Spanish blocklist pack
+var spanish = []string{
+	"cabr0n", "cabron", "caca", "cagante", "cagar", "ch1ng0", "ch1ngadaz0s", "ch1ngadazos",
+	"ch1ngader1ta", "ch1ngaderita", "ch1ngar", "ch1ngo", "ch1ngues", "ching0", "chingadaz0s",
+	"chingadazos", "chingader1ta", "chingaderita", "chingar", "chingo", "chingues", "cu10", "cu1er0",
+	"cu1ero", "cu1o", "cul0", "culer0", "culero", "culo", "estup1d0", "estup1do", "estupid0",
+	"estupido", "m1erda", "mam0n", "mamahuev0", "mamahuevo", "mamon", "mierda", "negr0", "negro",
+	"p011a", "p01la", "p0l1a", "p0lla", "pendej0", "pendejo", "po11a", "po1la", "pol1a", "polla",
+	"put1za", "puta", "putiza", "verga", "x0ch0ta", "x0chota", "xoch0ta", "xochota",
+}
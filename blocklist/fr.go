@@ -0,0 +1,18 @@
+package blocklist
+
+// french is the French blocklist pack
+var french = []string{
+	"1d10t", "1d1ot", "1di0t", "1diot", "1mbec11e", "1mbec1le", "1mbeci1e", "1mbecile", "ana1e",
+	"anale", "b1te", "bite", "bran1age", "bran1er", "bran1ette", "bran1eur", "bran1euse", "branlage",
+	"branler", "branlette", "branleur", "branleuse", "c0na", "c0nnard", "c0nnasse", "c0nne", "c0u111es",
+	"c0u11les", "c0u1l1es", "c0u1lles", "c0ui11es", "c0ui1les", "c0uil1es", "c0uilles", "c11t0",
+	"c11to", "c1it0", "c1ito", "ch1asse", "ch1er", "chatte", "chiasse", "chier", "cl1t0", "cl1to",
+	"clit0", "clito", "cona", "connard", "connasse", "conne", "cou111es", "cou11les", "cou1l1es",
+	"cou1lles", "coui11es", "coui1les", "couil1es", "couilles", "encu1e", "encule", "enf01re",
+	"enf0ire", "enfo1re", "enfoire", "etr0n", "etron", "f0utre", "foutre", "g0u1ne", "g0uine", "gou1ne",
+	"gouine", "gr0gnasse", "grognasse", "id10t", "id1ot", "idi0t", "idiot", "imbec11e", "imbec1le",
+	"imbeci1e", "imbecile", "merde", "negre", "p0uff1asse", "p0uffiasse", "p1p1", "p1pi", "p1sser",
+	"pip1", "pipi", "pisser", "pouff1asse", "pouffiasse", "puta1n", "putain", "pute", "sa10pe",
+	"sa1aud", "sa1ope", "sal0pe", "salaud", "salope", "tapette", "tr1ng1er", "tr1ngler", "tring1er",
+	"tringler", "z1z1", "z1zi", "ziz1", "zizi",
+}
@@ -0,0 +1,9 @@
+package blocklist
+
+// hindi is the Hindi blocklist pack
+var hindi = []string{
+	"aand", "ba1atkar", "balatkar", "ch00t1a", "ch00t1ya", "ch00tia", "ch00tiya", "ch0d", "ch0ot1a",
+	"ch0ot1ya", "ch0otia", "ch0otiya", "cho0t1a", "cho0t1ya", "cho0tia", "cho0tiya", "chod", "choot1a",
+	"choot1ya", "chootia", "chootiya", "gandu", "haram1", "harami", "haramzade", "kam1ne", "kamine",
+	"patakha", "rand1", "randi",
+}
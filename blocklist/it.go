@@ -0,0 +1,32 @@
+package blocklist
+
+// italian is the Italian blocklist pack
+var italian = []string{
+	"1d10t", "1d1ot", "1di0t", "1diot", "1eccacu10", "1eccacu1o", "1eccacul0", "1eccaculo", "1mbec11e",
+	"1mbec1le", "1mbeci1e", "1mbecile", "a11upat0", "a11upato", "a1lupat0", "a1lupato", "al1upat0",
+	"al1upato", "allupat0", "allupato", "ana1e", "anale", "arrapat0", "arrapato", "b01ata", "b0iata",
+	"bastard0", "bastardo", "batt0na", "battona", "bo1ata", "boiata", "c0g110ne", "c0g11one",
+	"c0g1i0ne", "c0g1ione", "c0gl10ne", "c0gl1one", "c0gli0ne", "c0glione", "cacca", "cagare", "cagna",
+	"cazz0", "cazz1mma", "cazzata", "cazzimma", "cazzo", "ch1avata", "chiavata", "cog110ne", "cog11one",
+	"cog1i0ne", "cog1ione", "cogl10ne", "cogl1one", "cogli0ne", "coglione", "cu10", "cu1att0ne",
+	"cu1attone", "cu1o", "cul0", "culatt0ne", "culattone", "culo", "f0ttere", "f0tters1", "f0ttersi",
+	"f1ca", "f1ga", "fica", "figa", "fottere", "fotters1", "fottersi", "fr0c10", "fr0c1o", "fr0ci0",
+	"fr0cio", "fr0sc10", "fr0sc1o", "fr0sci0", "fr0scio", "froc10", "froc1o", "froci0", "frocio",
+	"frosc10", "frosc1o", "frosci0", "froscio", "id10t", "id1ot", "idi0t", "idiot", "imbec11e",
+	"imbec1le", "imbeci1e", "imbecile", "leccacu10", "leccacu1o", "leccacul0", "leccaculo", "m1gn0tta",
+	"m1gnotta", "m1nch1a", "m1nchia", "merd0s0", "merd0so", "merda", "merdos0", "merdoso", "mign0tta",
+	"mignotta", "minch1a", "minchia", "negr0", "negro", "nerch1a", "nerchia", "p0mp1n0", "p0mp1no",
+	"p0mpin0", "p0mpino", "p0rca", "p1p1", "p1pi", "p1r1a", "p1rla", "p1sc10", "p1sc1o", "p1sci0",
+	"p1scio", "pa11e", "pa1le", "pal1e", "palle", "pec0r1na", "pec0rina", "pecor1na", "pecorina",
+	"pip1", "pipi", "pir1a", "pirla", "pisc10", "pisc1o", "pisci0", "piscio", "pomp1n0", "pomp1no",
+	"pompin0", "pompino", "porca", "puttana", "r0mp1ba11e", "r0mp1ba1le", "r0mp1bal1e", "r0mp1balle",
+	"r0mpiba11e", "r0mpiba1le", "r0mpibal1e", "r0mpiballe", "recch10ne", "recch1one", "recchi0ne",
+	"recchione", "romp1ba11e", "romp1ba1le", "romp1bal1e", "romp1balle", "rompiba11e", "rompiba1le",
+	"rompibal1e", "rompiballe", "ruff1an0", "ruff1ano", "ruffian0", "ruffiano", "sb0rr0ne", "sb0rra",
+	"sb0rrone", "sbattere", "sbatters1", "sbattersi", "sborr0ne", "sborra", "sborrone", "sc0pare",
+	"sc0pata", "scopare", "scopata", "sp0mp1nare", "sp0mpinare", "spomp1nare", "spompinare", "str0nz0",
+	"str0nza", "str0nzo", "stronz0", "stronza", "stronzo", "succh1am1", "succh1ami", "succhiam1",
+	"succhiami", "t0pa", "tette", "topa", "tr01a", "tr0ia", "tr0mbare", "tro1a", "troia", "trombare",
+	"vaffancu10", "vaffancu1o", "vaffancul0", "vaffanculo", "z0cc01a", "z0cc0la", "z0cco1a", "z0ccola",
+	"zocc01a", "zocc0la", "zocco1a", "zoccola",
+}
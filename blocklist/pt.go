@@ -0,0 +1,10 @@
+package blocklist
+
+// portuguese is the Portuguese blocklist pack
+var portuguese = []string{
+	"b0ceta", "b0sta", "boceta", "bosta", "c0na", "cabra0", "cabrao", "cacete", "cara1h0", "cara1ho",
+	"caracu10", "caracu1o", "caracul0", "caraculo", "caralh0", "caralho", "cona", "f0da", "f0der",
+	"foda", "foder", "merda", "negr0", "negro", "p0rra", "pane1e1r0", "pane1e1ro", "pane1eir0",
+	"pane1eiro", "panele1r0", "panele1ro", "paneleir0", "paneleiro", "porra", "puta", "queca",
+	"sacanagem", "xana",
+}
@@ -0,0 +1,133 @@
+package sqids
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrBlocklistLineInvalid is wrapped by a BlocklistLineError
+var ErrBlocklistLineInvalid = errors.New("invalid blocklist line")
+
+// BlocklistLineError reports an invalid line of a blocklist file
+type BlocklistLineError struct {
+	Line int
+	Text string
+}
+
+func (e *BlocklistLineError) Error() string {
+	return fmt.Sprintf("line %d: %v: %q", e.Line, ErrBlocklistLineInvalid, e.Text)
+}
+
+func (e *BlocklistLineError) Unwrap() error {
+	return ErrBlocklistLineInvalid
+}
+
+// LoadBlocklist reads a blocklist with one word per line, which may be
+// gzip-compressed. Everything after a # is a comment, blank lines are
+// skipped, and words are lowercased and deduplicated.
+//
+// Lines holding more than one word, or characters that are not printable,
+// are invalid. All invalid lines are reported at once as BlocklistLineErrors.
+func LoadBlocklist(r io.Reader) ([]string, error) {
+	br := bufio.NewReader(r)
+
+	// gzip streams start with the magic bytes 1f 8b
+	if magic, err := br.Peek(2); err == nil && bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+
+		br = bufio.NewReader(zr)
+	}
+
+	var (
+		errs    []error
+		words   = []string{}
+		seen    = make(map[string]bool)
+		scanner = bufio.NewScanner(br)
+		line    = 0
+	)
+
+	for scanner.Scan() {
+		line++
+
+		text := scanner.Text()
+
+		word, _, _ := strings.Cut(text, "#")
+		word = strings.TrimSpace(word)
+
+		if word == "" {
+			continue
+		}
+
+		if !validBlocklistWord(word) {
+			errs = append(errs, &BlocklistLineError{Line: line, Text: text})
+			continue
+		}
+
+		word = strings.ToLower(word)
+
+		if !seen[word] {
+			seen[word] = true
+			words = append(words, word)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return words, nil
+}
+
+// LoadBlocklistFile reads a blocklist file, see LoadBlocklist
+func LoadBlocklistFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return LoadBlocklist(f)
+}
+
+// LoadBlocklistFS reads a blocklist file from fsys, such as an embed.FS,
+// see LoadBlocklist
+func LoadBlocklistFS(fsys fs.FS, path string) ([]string, error) {
+	f, err := fsys.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return LoadBlocklist(f)
+}
+
+func validBlocklistWord(word string) bool {
+	if !utf8.ValidString(word) {
+		return false
+	}
+
+	for _, r := range word {
+		if unicode.IsSpace(r) || !unicode.IsPrint(r) {
+			return false
+		}
+	}
+
+	return true
+}
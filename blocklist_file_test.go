@@ -0,0 +1,89 @@
+package sqids
+
+import (
+	"bytes"
+	"compress/gzip"
+	"embed"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+//go:embed testdata/blocklist.txt
+var testdata embed.FS
+
+func TestLoadBlocklist(t *testing.T) {
+	words, err := LoadBlocklistFile("testdata/blocklist.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"foo", "bar", "baz"}; !reflect.DeepEqual(words, want) {
+		t.Errorf("LoadBlocklistFile() = %v, want %v", words, want)
+	}
+
+	embedded, err := LoadBlocklistFS(testdata, "testdata/blocklist.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(embedded, words) {
+		t.Errorf("LoadBlocklistFS() = %v, want %v", embedded, words)
+	}
+}
+
+func TestLoadBlocklistGzip(t *testing.T) {
+	data, err := os.ReadFile("testdata/blocklist.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+
+	zw := gzip.NewWriter(&buf)
+	zw.Write(data)
+	zw.Close()
+
+	path := filepath.Join(t.TempDir(), "blocklist.txt.gz")
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	words, err := LoadBlocklistFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"foo", "bar", "baz"}; !reflect.DeepEqual(words, want) {
+		t.Errorf("LoadBlocklistFile() = %v, want %v", words, want)
+	}
+}
+
+func TestLoadBlocklistInvalidLines(t *testing.T) {
+	_, err := LoadBlocklist(strings.NewReader("foo\nfoo bar\nbaz\nqu\x00x\n"))
+
+	if !errors.Is(err, ErrBlocklistLineInvalid) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var lines []int
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var lineErr *BlocklistLineError
+		if errors.As(err, &lineErr) {
+			lines = append(lines, lineErr.Line)
+		}
+	}
+
+	if want := []int{2, 4}; !reflect.DeepEqual(lines, want) {
+		t.Errorf("invalid lines = %v, want %v", lines, want)
+	}
+}
+
+func TestLoadBlocklistFileMissing(t *testing.T) {
+	if _, err := LoadBlocklistFile("testdata/missing.txt"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+package sqids
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// BlocklistReport describes how a blocklist is filtered for an alphabet,
+// see AnalyzeBlocklist
+type BlocklistReport struct {
+	// Kept are the lowercased words that are used to block IDs
+	Kept []string `json:"kept"`
+
+	// Dropped are the words that are removed by the filter
+	Dropped []DroppedWord `json:"dropped"`
+
+	// Duplicates are the kept words that were given more than once
+	Duplicates []string `json:"duplicates"`
+
+	// Unreachable are kept words that never block an ID by themselves,
+	// because every ID they match is already blocked by a shorter word
+	Unreachable []UnreachableWord `json:"unreachable"`
+}
+
+// DroppedWord is a blocklist word removed by the filter
+type DroppedWord struct {
+	Word   string `json:"word"`
+	Reason string `json:"reason"`
+}
+
+// UnreachableWord is a blocklist word made redundant by another word
+type UnreachableWord struct {
+	Word       string `json:"word"`
+	ShadowedBy string `json:"shadowedBy"`
+}
+
+// Reasons for dropping a blocklist word
+const (
+	DropReasonTooShort      = "shorter than 3 characters"
+	DropReasonNotInAlphabet = "contains characters not in the alphabet"
+)
+
+// AnalyzeBlocklist reports which words of the blocklist are kept or dropped
+// for the alphabet, and why. A nil blocklist is the default blocklist.
+func AnalyzeBlocklist(alphabet string, words []string) BlocklistReport {
+	if alphabet == "" {
+		alphabet = defaultAlphabet
+	}
+
+	if words == nil {
+		words = defaultBlocklist
+	}
+
+	var (
+		report = BlocklistReport{
+			Kept:        []string{},
+			Dropped:     []DroppedWord{},
+			Duplicates:  []string{},
+			Unreachable: []UnreachableWord{},
+		}
+		alphabetChars = strings.Split(strings.ToLower(alphabet), "")
+		seen          = make(map[string]bool)
+	)
+
+	for _, word := range words {
+		wordLower := strings.ToLower(word)
+
+		switch {
+		case utf8.RuneCountInString(word) < 3:
+			report.Dropped = append(report.Dropped, DroppedWord{Word: word, Reason: DropReasonTooShort})
+		case !wordInAlphabet(wordLower, alphabetChars):
+			report.Dropped = append(report.Dropped, DroppedWord{Word: word, Reason: DropReasonNotInAlphabet})
+		case seen[wordLower]:
+			report.Duplicates = append(report.Duplicates, wordLower)
+		default:
+			seen[wordLower] = true
+			report.Kept = append(report.Kept, wordLower)
+		}
+	}
+
+	for _, word := range report.Kept {
+		if by, ok := shadowedBy(word, report.Kept); ok {
+			report.Unreachable = append(report.Unreachable, UnreachableWord{Word: word, ShadowedBy: by})
+		}
+	}
+
+	return report
+}
+
+// shadowedBy returns a word that blocks every ID that word blocks, following
+// the rules of isBlockedID. Words of 3 characters or less only block IDs
+// equal to them, so they neither shadow nor are shadowed.
+func shadowedBy(word string, words []string) (string, bool) {
+	if utf8.RuneCountInString(word) <= 3 {
+		return "", false
+	}
+
+	for _, other := range words {
+		if other == word || utf8.RuneCountInString(other) <= 3 {
+			continue
+		}
+
+		if hasDigit(other) {
+			// other only matches at the start or end of an ID, so it has to
+			// be at both ends of word to match wherever word matches
+			if strings.HasPrefix(word, other) && strings.HasSuffix(word, other) {
+				return other, true
+			}
+		} else if strings.Contains(word, other) {
+			return other, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,65 @@
+package sqids
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAnalyzeBlocklist(t *testing.T) {
+	report := AnalyzeBlocklist("abcdefghijklmnopqrstuvwxyz1", []string{
+		"ab", "Hello", "hello", "héllo", "hellos", "1hel", "1hel1", "zab", "zabc", "zabz",
+	})
+
+	if want := []string{"hello", "hellos", "1hel", "1hel1", "zab", "zabc", "zabz"}; !reflect.DeepEqual(report.Kept, want) {
+		t.Errorf("Kept = %v, want %v", report.Kept, want)
+	}
+
+	if want := []DroppedWord{
+		{Word: "ab", Reason: DropReasonTooShort},
+		{Word: "héllo", Reason: DropReasonNotInAlphabet},
+	}; !reflect.DeepEqual(report.Dropped, want) {
+		t.Errorf("Dropped = %v, want %v", report.Dropped, want)
+	}
+
+	if want := []string{"hello"}; !reflect.DeepEqual(report.Duplicates, want) {
+		t.Errorf("Duplicates = %v, want %v", report.Duplicates, want)
+	}
+
+	if want := []UnreachableWord{
+		{Word: "hellos", ShadowedBy: "hello"},
+	}; !reflect.DeepEqual(report.Unreachable, want) {
+		t.Errorf("Unreachable = %v, want %v", report.Unreachable, want)
+	}
+}
+
+func TestAnalyzeBlocklistMatchesFilter(t *testing.T) {
+	for _, alphabet := range []string{defaultAlphabet, AlphabetCrockford, AlphabetNumeric, "YESNO"} {
+		report := AnalyzeBlocklist(alphabet, nil)
+
+		if filtered := filterBlocklist(alphabet, nil); !reflect.DeepEqual(report.Kept, filtered) {
+			t.Errorf("%s: kept %d words, filterBlocklist keeps %d", alphabet, len(report.Kept), len(filtered))
+		}
+
+		if got, want := len(report.Kept)+len(report.Dropped)+len(report.Duplicates), len(defaultBlocklist); got != want {
+			t.Errorf("%s: report covers %d words, want %d", alphabet, got, want)
+		}
+	}
+}
+
+func TestAnalyzeBlocklistUnreachable(t *testing.T) {
+	report := AnalyzeBlocklist("", []string{"hello", "hellos"})
+
+	s, err := New(Options{Blocklist: []string{"hello"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// every ID blocked by the unreachable word is blocked without it
+	for _, u := range report.Unreachable {
+		for _, id := range []string{u.Word, "x" + u.Word, u.Word + "x"} {
+			if !s.isBlockedID(id) {
+				t.Errorf("%q should be blocked by %q", id, u.ShadowedBy)
+			}
+		}
+	}
+}
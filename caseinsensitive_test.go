@@ -0,0 +1,98 @@
+package sqids
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCaseInsensitive(t *testing.T) {
+	s, err := New(Options{
+		CaseInsensitive: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := s.Options().Alphabet, defaultCaseInsensitiveAlphabet; got != want {
+		t.Errorf("Alphabet = %q, want %q", got, want)
+	}
+
+	for _, numbers := range [][]uint64{
+		{minUint64Value},
+		{1, 2, 3},
+		{1000000},
+		{maxUint64Value},
+	} {
+		generatedID, err := s.Encode(numbers)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if generatedID != strings.ToLower(generatedID) {
+			t.Errorf("Encoding `%v` produced `%v`, which is not lowercase", numbers, generatedID)
+		}
+
+		for _, id := range []string{generatedID, strings.ToUpper(generatedID)} {
+			decodedNumbers := s.Decode(id)
+			if !reflect.DeepEqual(numbers, decodedNumbers) {
+				t.Errorf("Decoding `%v` should produce `%v`, but instead produced `%v`", id, numbers, decodedNumbers)
+			}
+		}
+	}
+}
+
+func TestCaseInsensitiveMixedCaseAlphabet(t *testing.T) {
+	s, err := New(Options{
+		Alphabet:        "aBcDeFgHiJ",
+		CaseInsensitive: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	numbers := []uint64{1, 2, 3}
+
+	generatedID, err := s.Encode(numbers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{strings.ToLower(generatedID), strings.ToUpper(generatedID)} {
+		decodedNumbers := s.Decode(id)
+		if !reflect.DeepEqual(numbers, decodedNumbers) {
+			t.Errorf("Decoding `%v` should produce `%v`, but instead produced `%v`", id, numbers, decodedNumbers)
+		}
+	}
+}
+
+func TestCaseInsensitiveAlphabetConflict(t *testing.T) {
+	_, err := New(Options{
+		Alphabet:        "abcABC",
+		CaseInsensitive: true,
+	})
+
+	if !errors.Is(err, ErrAlphabetCaseConflict) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(err.Error(), `"ABC"`) {
+		t.Errorf("error %v should report the conflicting characters", err)
+	}
+
+	if _, err := New(Options{CaseInsensitive: true, Alphabet: defaultAlphabet}); !errors.Is(err, ErrAlphabetCaseConflict) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCaseSensitiveDecode(t *testing.T) {
+	s, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := s.Decode(strings.ToUpper("86Rf07")); reflect.DeepEqual(got, []uint64{1, 2, 3}) {
+		t.Errorf("Decoding should be case-sensitive by default")
+	}
+}
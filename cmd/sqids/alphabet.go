@@ -0,0 +1,106 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/sqids/sqids-go"
+)
+
+// presets are the alphabets gen-alphabet starts from, where the empty
+// alphabet is the default alphabet
+var presets = map[string]string{
+	"default":     "",
+	"crockford":   sqids.AlphabetCrockford,
+	"numeric":     sqids.AlphabetNumeric,
+	"lower-alnum": sqids.AlphabetLowerAlnum,
+	"unambiguous": sqids.AlphabetUnambiguous,
+}
+
+type genAlphabetResult struct {
+	Preset   string `json:"preset"`
+	Seed     uint64 `json:"seed"`
+	Alphabet string `json:"alphabet"`
+}
+
+func runGenAlphabet(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	const name = "gen-alphabet"
+
+	fs := newFlagSet(name, stderr)
+	preset := fs.String("preset", "default", "alphabet to shuffle, one of "+presetNames())
+	seed := fs.Uint64("seed", 0, "seed of the shuffle (random if not given)")
+	asJSON := fs.Bool("json", false, "write the preset, seed and alphabet as JSON")
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	alphabet, ok := presets[*preset]
+	if !ok {
+		fmt.Fprintf(stderr, "sqids %s: unknown preset %q, want one of %s\n", name, *preset, presetNames())
+		return exitUsage
+	}
+
+	if !isFlagSet(fs, "seed") {
+		var b [8]byte
+		if _, err := rand.Read(b[:]); err != nil {
+			return fail(stderr, name, err)
+		}
+
+		*seed = binary.LittleEndian.Uint64(b[:])
+	}
+
+	opts := []sqids.Option{sqids.WithSeed(*seed)}
+	if alphabet != "" {
+		opts = append(opts, sqids.WithAlphabet(alphabet))
+	}
+
+	s, err := sqids.NewWith(opts...)
+	if err != nil {
+		return fail(stderr, name, err)
+	}
+
+	result := genAlphabetResult{*preset, *seed, s.Options().Alphabet}
+
+	if *asJSON {
+		if err := writeJSON(stdout, result); err != nil {
+			return fail(stderr, name, err)
+		}
+
+		return exitOK
+	}
+
+	fmt.Fprintln(stdout, result.Alphabet)
+
+	return exitOK
+}
+
+// presetNames returns the sorted preset names separated by commas
+func presetNames() string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return strings.Join(names, ", ")
+}
+
+// isFlagSet reports whether the named flag was given on the command line
+func isFlagSet(fs *flag.FlagSet, name string) bool {
+	set := false
+
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+
+	return set
+}
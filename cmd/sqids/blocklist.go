@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/sqids/sqids-go"
+)
+
+func runAnalyzeBlocklist(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	const name = "analyze-blocklist"
+
+	fs := newFlagSet(name, stderr)
+	of := addOptionsFlags(fs)
+	asJSON := fs.Bool("json", false, "write the report as JSON")
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	o, err := of.options()
+	if err != nil {
+		return fail(stderr, name, err)
+	}
+
+	report := sqids.AnalyzeBlocklist(o.Alphabet, o.Blocklist)
+
+	if *asJSON {
+		if err := writeJSON(stdout, report); err != nil {
+			return fail(stderr, name, err)
+		}
+
+		return exitOK
+	}
+
+	fmt.Fprintf(stdout, "kept: %d words\n", len(report.Kept))
+
+	for _, d := range report.Dropped {
+		fmt.Fprintf(stdout, "dropped: %s (%s)\n", d.Word, d.Reason)
+	}
+
+	for _, word := range report.Duplicates {
+		fmt.Fprintf(stdout, "duplicate: %s\n", word)
+	}
+
+	for _, u := range report.Unreachable {
+		fmt.Fprintf(stdout, "unreachable: %s (shadowed by %s)\n", u.Word, u.ShadowedBy)
+	}
+
+	return exitOK
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sqids/sqids-go"
+)
+
+// shortAlphabetLength is the alphabet length below which check-config
+// warns that IDs get long and easy to guess
+const shortAlphabetLength = 16
+
+type checkConfigResult struct {
+	Fingerprint   string              `json:"fingerprint"`
+	AlphabetSize  int                 `json:"alphabetSize"`
+	BlocklistSize int                 `json:"blocklistSize"`
+	Dropped       []sqids.DroppedWord `json:"dropped"`
+	Warnings      []string            `json:"warnings"`
+}
+
+func runCheckConfig(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	const name = "check-config"
+
+	fs := newFlagSet(name, stderr)
+	configFile := fs.String("config", "", "JSON options file")
+	envPrefix := fs.String("env-prefix", "", `read the options from environment variables with this prefix, such as "SQIDS"`)
+	strict := fs.Bool("strict", false, "exit with a failure if there are warnings")
+	asJSON := fs.Bool("json", false, "write the result as JSON")
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if *configFile != "" && *envPrefix != "" {
+		fmt.Fprintf(stderr, "sqids %s: -config and -env-prefix cannot be used together\n", name)
+		return exitUsage
+	}
+
+	var (
+		o   sqids.Options
+		err error
+	)
+
+	if *envPrefix != "" {
+		o, err = sqids.OptionsFromEnv(*envPrefix)
+	} else {
+		o, err = loadOptionsFile(*configFile)
+	}
+
+	if err != nil {
+		return fail(stderr, name, err)
+	}
+
+	s, err := sqids.New(o)
+	if err != nil {
+		return fail(stderr, name, err)
+	}
+
+	result, err := checkConfig(o, s)
+	if err != nil {
+		return fail(stderr, name, err)
+	}
+
+	if *asJSON {
+		if err := writeJSON(stdout, result); err != nil {
+			return fail(stderr, name, err)
+		}
+	} else {
+		fmt.Fprintf(stdout, "fingerprint: %s\n", result.Fingerprint)
+		fmt.Fprintf(stdout, "alphabet: %d characters\n", result.AlphabetSize)
+		fmt.Fprintf(stdout, "blocklist: %d words\n", result.BlocklistSize)
+
+		for _, d := range result.Dropped {
+			fmt.Fprintf(stdout, "dropped: %s (%s)\n", d.Word, d.Reason)
+		}
+
+		for _, w := range result.Warnings {
+			fmt.Fprintf(stdout, "warning: %s\n", w)
+		}
+	}
+
+	if *strict && len(result.Warnings) > 0 {
+		return exitFailure
+	}
+
+	return exitOK
+}
+
+// checkConfig describes the Sqids instance s created from the options o,
+// without revealing its alphabet
+func checkConfig(o sqids.Options, s *sqids.Sqids) (checkConfigResult, error) {
+	effective := s.Options()
+
+	result := checkConfigResult{
+		Fingerprint:   s.Fingerprint(),
+		AlphabetSize:  len([]rune(effective.Alphabet)),
+		BlocklistSize: len(effective.Blocklist),
+		Warnings:      []string{},
+	}
+
+	defaults, err := sqids.New(sqids.Options{CaseInsensitive: o.CaseInsensitive})
+	if err != nil {
+		return result, err
+	}
+
+	if effective.Alphabet == defaults.Options().Alphabet {
+		result.Warnings = append(result.Warnings, "the default alphabet is in use, IDs can be decoded by anyone")
+	}
+
+	if result.AlphabetSize < shortAlphabetLength {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("the alphabet has only %d characters, IDs will be long", result.AlphabetSize))
+	}
+
+	if result.Dropped, err = droppedWords(o, effective.Alphabet); err != nil {
+		return result, err
+	}
+
+	if len(result.Dropped) > 0 {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("%d blocklist words are dropped for this alphabet", len(result.Dropped)))
+	}
+
+	return result, nil
+}
+
+// droppedWords returns the words of the blocklist of o that are not from
+// the default blocklist, and that an instance with the options o filters
+// out for the alphabet
+func droppedWords(o sqids.Options, alphabet string) ([]sqids.DroppedWord, error) {
+	dropped := []sqids.DroppedWord{}
+
+	defaults := make(map[string]bool)
+	for _, word := range sqids.Blocklist() {
+		defaults[word] = true
+	}
+
+	for _, word := range o.Blocklist {
+		if defaults[strings.ToLower(word)] {
+			continue
+		}
+
+		if utf8.RuneCountInString(word) < 3 {
+			dropped = append(dropped, sqids.DroppedWord{Word: word, Reason: sqids.DropReasonTooShort})
+			continue
+		}
+
+		// filter the word the same way as the instance does
+		s, err := sqids.New(sqids.Options{
+			Alphabet:           alphabet,
+			AllowUnicode:       o.AllowUnicode,
+			Blocklist:          []string{word},
+			LeetspeakBlocklist: o.LeetspeakBlocklist,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(s.Options().Blocklist) == 0 {
+			dropped = append(dropped, sqids.DroppedWord{Word: word, Reason: sqids.DropReasonNotInAlphabet})
+		}
+	}
+
+	return dropped, nil
+}
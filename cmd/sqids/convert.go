@@ -0,0 +1,299 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/sqids/sqids-go"
+)
+
+func runConvert(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	const name = "convert"
+
+	fs := newFlagSet(name, stderr)
+	f := addOptionsFlags(fs)
+	format := fs.String("format", "csv", `input and output format, "csv" or "jsonl"`)
+	input := fs.String("input", "-", `input file, "-" for stdin`)
+	encode := fs.String("encode", "", "comma-separated columns to encode into one ID, in order")
+	decode := fs.String("decode", "", "column with IDs to decode")
+	to := fs.String("to", "", `comma-separated columns to write, one for the ID or the numbers, or one per number (default "sqid" or "numbers")`)
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if (*encode == "") == (*decode == "") {
+		fmt.Fprintf(stderr, "sqids %s: exactly one of -encode and -decode is required\n", name)
+		return exitUsage
+	}
+
+	s, err := f.sqids()
+	if err != nil {
+		return fail(stderr, name, err)
+	}
+
+	c := &converter{s: s, decode: *decode}
+
+	if *encode != "" {
+		c.encode = strings.Split(*encode, ",")
+		c.to = []string{"sqid"}
+	} else {
+		c.to = []string{"numbers"}
+	}
+
+	if *to != "" {
+		c.to = strings.Split(*to, ",")
+	}
+
+	if c.encode != nil && len(c.to) != 1 {
+		fmt.Fprintf(stderr, "sqids %s: -encode writes a single column\n", name)
+		return exitUsage
+	}
+
+	r := stdin
+
+	if *input != "-" {
+		file, err := os.Open(*input)
+		if err != nil {
+			return fail(stderr, name, err)
+		}
+		defer file.Close()
+
+		r = file
+	}
+
+	switch *format {
+	case "csv":
+		err = c.convertCSV(r, stdout)
+	case "jsonl":
+		err = c.convertJSONL(r, stdout)
+	default:
+		fmt.Fprintf(stderr, "sqids %s: unknown format %q\n", name, *format)
+		return exitUsage
+	}
+
+	if err != nil {
+		return fail(stderr, name, err)
+	}
+
+	return exitOK
+}
+
+// converter encodes columns into an ID, or decodes a column into numbers,
+// one record at a time
+type converter struct {
+	s      *sqids.Sqids
+	encode []string
+	decode string
+	to     []string
+}
+
+// convert returns the values of the output columns of a record, given a
+// lookup of the record's input columns
+func (c *converter) convert(field func(name string) (string, bool)) ([]string, []uint64, error) {
+	if c.encode != nil {
+		numbers := make([]uint64, len(c.encode))
+
+		for i, name := range c.encode {
+			value, ok := field(name)
+			if !ok {
+				return nil, nil, fmt.Errorf("missing column %q", name)
+			}
+
+			n, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+			if err != nil {
+				return nil, nil, fmt.Errorf("column %q: invalid number %q", name, value)
+			}
+
+			numbers[i] = n
+		}
+
+		id, err := c.s.Encode(numbers)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return []string{id}, nil, nil
+	}
+
+	id, ok := field(c.decode)
+	if !ok {
+		return nil, nil, fmt.Errorf("missing column %q", c.decode)
+	}
+
+	numbers := c.s.Decode(id)
+	if len(numbers) == 0 {
+		return nil, nil, fmt.Errorf("column %q: invalid ID %q", c.decode, id)
+	}
+
+	if len(c.to) == 1 {
+		return []string{formatNumbers(numbers)}, numbers, nil
+	}
+
+	if len(numbers) != len(c.to) {
+		return nil, nil, fmt.Errorf("column %q: ID %q has %d numbers, want %d", c.decode, id, len(numbers), len(c.to))
+	}
+
+	values := make([]string, len(numbers))
+	for i, n := range numbers {
+		values[i] = strconv.FormatUint(n, 10)
+	}
+
+	return values, numbers, nil
+}
+
+// convertCSV converts a CSV file with a header row, appending the output
+// columns to each record
+func (c *converter) convertCSV(r io.Reader, w io.Writer) error {
+	cr := csv.NewReader(r)
+	cr.ReuseRecord = true
+
+	cw := csv.NewWriter(w)
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[name] = i
+	}
+
+	for _, name := range c.to {
+		if _, ok := columns[name]; ok {
+			return fmt.Errorf("column %q already exists", name)
+		}
+	}
+
+	if err := cw.Write(append(header, c.to...)); err != nil {
+		return err
+	}
+
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		values, _, err := c.convert(func(name string) (string, bool) {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return "", false
+			}
+
+			return record[i], true
+		})
+		if err != nil {
+			line, _ := cr.FieldPos(0)
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+
+		if err := cw.Write(append(record, values...)); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// convertJSONL converts a file of JSON objects, one per line, appending
+// the output fields to each object without reordering its fields
+func (c *converter) convertJSONL(r io.Reader, w io.Writer) error {
+	br := bufio.NewReader(r)
+	bw := bufio.NewWriter(w)
+
+	for line := 1; ; line++ {
+		data, err := br.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		if object := bytes.TrimSpace(data); len(object) > 0 {
+			converted, err := c.convertObject(object)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", line, err)
+			}
+
+			bw.Write(converted)
+			bw.WriteByte('\n')
+		}
+
+		if err == io.EOF {
+			break
+		}
+	}
+
+	return bw.Flush()
+}
+
+func (c *converter) convertObject(object []byte) ([]byte, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(object, &fields); err != nil || fields == nil {
+		return nil, errors.New("not a JSON object")
+	}
+
+	for _, name := range c.to {
+		if _, ok := fields[name]; ok {
+			return nil, fmt.Errorf("field %q already exists", name)
+		}
+	}
+
+	values, numbers, err := c.convert(func(name string) (string, bool) {
+		raw, ok := fields[name]
+		if !ok {
+			return "", false
+		}
+
+		var s string
+		if json.Unmarshal(raw, &s) == nil {
+			return s, true
+		}
+
+		return string(raw), true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := bytes.NewBuffer(object[: len(object)-1 : len(object)-1])
+
+	for i, name := range c.to {
+		if len(fields) > 0 || i > 0 {
+			out.WriteByte(',')
+		}
+
+		key, _ := json.Marshal(name)
+		out.Write(key)
+		out.WriteByte(':')
+
+		switch {
+		case c.encode != nil:
+			value, _ := json.Marshal(values[i])
+			out.Write(value)
+		case len(c.to) == 1:
+			value, _ := json.Marshal(numbers)
+			out.Write(value)
+		default:
+			out.WriteString(values[i])
+		}
+	}
+
+	out.WriteByte('}')
+
+	return out.Bytes(), nil
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/sqids/sqids-go"
+)
+
+// newFlagSet returns a flag set for the named command that reports
+// errors instead of exiting
+func newFlagSet(name string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet("sqids "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	return fs
+}
+
+// optionsFlags are the flags that configure a Sqids instance
+type optionsFlags struct {
+	alphabet      string
+	minLength     uint
+	blocklistFile string
+}
+
+func addOptionsFlags(fs *flag.FlagSet) *optionsFlags {
+	f := &optionsFlags{}
+
+	fs.StringVar(&f.alphabet, "alphabet", "", "alphabet (default alphabet if empty)")
+	fs.UintVar(&f.minLength, "min-length", 0, "minimum length of IDs")
+	fs.StringVar(&f.blocklistFile, "blocklist-file", "", "file with one blocklist word per line (default blocklist if empty)")
+
+	return f
+}
+
+// options returns the options given by the flags
+func (f *optionsFlags) options() (sqids.Options, error) {
+	if f.minLength > math.MaxUint8 {
+		return sqids.Options{}, fmt.Errorf("min length must be at most %d", math.MaxUint8)
+	}
+
+	o := sqids.Options{
+		Alphabet:  f.alphabet,
+		MinLength: uint8(f.minLength),
+	}
+
+	if f.blocklistFile != "" {
+		words, err := sqids.LoadBlocklistFile(f.blocklistFile)
+		if err != nil {
+			return sqids.Options{}, err
+		}
+
+		o.Blocklist = words
+	}
+
+	return o, nil
+}
+
+// sqids returns a Sqids instance configured by the flags
+func (f *optionsFlags) sqids() (*sqids.Sqids, error) {
+	o, err := f.options()
+	if err != nil {
+		return nil, err
+	}
+
+	return sqids.New(o)
+}
+
+// eachInput calls fn with each argument, or with each non-blank line of
+// stdin if there are no arguments
+func eachInput(args []string, stdin io.Reader, fn func(string)) error {
+	if len(args) > 0 {
+		for _, arg := range args {
+			fn(arg)
+		}
+
+		return nil
+	}
+
+	scanner := bufio.NewScanner(stdin)
+
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			fn(line)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// writeJSON writes v as a single line of JSON
+func writeJSON(w io.Writer, v any) error {
+	return json.NewEncoder(w).Encode(v)
+}
+
+// fail reports an error of the named command
+func fail(stderr io.Writer, name string, err error) int {
+	fmt.Fprintf(stderr, "sqids %s: %v\n", name, err)
+
+	return exitFailure
+}
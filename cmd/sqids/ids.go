@@ -0,0 +1,227 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/sqids/sqids-go"
+)
+
+var errInvalidID = errors.New("invalid ID")
+
+// idsCommand holds what the encode, decode, inspect and validate
+// commands share: the Sqids instance, the output format and the
+// exit code so far
+type idsCommand struct {
+	name   string
+	s      *sqids.Sqids
+	asJSON bool
+	stdout io.Writer
+	stderr io.Writer
+	code   int
+}
+
+// newIDsCommand parses the flags of the named command, and returns the
+// command and its remaining arguments. It returns a non-zero exit code
+// if the flags or options are invalid.
+func newIDsCommand(name string, args []string, stdout, stderr io.Writer) (*idsCommand, []string, int) {
+	fs := newFlagSet(name, stderr)
+	f := addOptionsFlags(fs)
+	asJSON := fs.Bool("json", false, "write one JSON object per line")
+
+	if err := fs.Parse(args); err != nil {
+		return nil, nil, exitUsage
+	}
+
+	s, err := f.sqids()
+	if err != nil {
+		return nil, nil, fail(stderr, name, err)
+	}
+
+	return &idsCommand{
+		name:   name,
+		s:      s,
+		asJSON: *asJSON,
+		stdout: stdout,
+		stderr: stderr,
+	}, fs.Args(), exitOK
+}
+
+// fail reports an error for a single input and carries on with the next one
+func (c *idsCommand) fail(input string, err error) {
+	fmt.Fprintf(c.stderr, "sqids %s: %q: %v\n", c.name, input, err)
+	c.code = exitFailure
+}
+
+// write writes v as JSON, or text as plain text
+func (c *idsCommand) write(v any, text string) {
+	if c.asJSON {
+		if err := writeJSON(c.stdout, v); err != nil {
+			c.code = fail(c.stderr, c.name, err)
+		}
+
+		return
+	}
+
+	fmt.Fprintln(c.stdout, text)
+}
+
+// run calls fn for each input and returns the exit code
+func (c *idsCommand) run(args []string, stdin io.Reader, fn func(string)) int {
+	if err := eachInput(args, stdin, fn); err != nil {
+		return fail(c.stderr, c.name, err)
+	}
+
+	return c.code
+}
+
+// inspect inspects id, treating IDs without numbers as invalid
+func (c *idsCommand) inspect(id string) (*sqids.Inspection, error) {
+	in, err := c.s.Inspect(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(in.Numbers) == 0 {
+		return nil, errInvalidID
+	}
+
+	return in, nil
+}
+
+type encodeResult struct {
+	Numbers []uint64 `json:"numbers"`
+	ID      string   `json:"id"`
+}
+
+func runEncode(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	c, args, code := newIDsCommand("encode", args, stdout, stderr)
+	if c == nil {
+		return code
+	}
+
+	return c.run(args, stdin, func(input string) {
+		numbers, err := parseNumbers(input)
+		if err != nil {
+			c.fail(input, err)
+			return
+		}
+
+		id, err := c.s.Encode(numbers)
+		if err != nil {
+			c.fail(input, err)
+			return
+		}
+
+		c.write(encodeResult{numbers, id}, id)
+	})
+}
+
+type decodeResult struct {
+	ID      string   `json:"id"`
+	Numbers []uint64 `json:"numbers"`
+}
+
+func runDecode(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	c, args, code := newIDsCommand("decode", args, stdout, stderr)
+	if c == nil {
+		return code
+	}
+
+	return c.run(args, stdin, func(id string) {
+		in, err := c.inspect(id)
+		if err != nil {
+			c.fail(id, err)
+			return
+		}
+
+		c.write(decodeResult{id, in.Numbers}, formatNumbers(in.Numbers))
+	})
+}
+
+type inspectResult struct {
+	ID        string        `json:"id"`
+	Prefix    string        `json:"prefix"`
+	Offset    int           `json:"offset"`
+	Increment int           `json:"increment"`
+	Chunks    []chunkResult `json:"chunks"`
+	Padding   string        `json:"padding"`
+	Numbers   []uint64      `json:"numbers"`
+	Canonical string        `json:"canonical"`
+}
+
+type chunkResult struct {
+	Start     int    `json:"start"`
+	Value     string `json:"value"`
+	Number    uint64 `json:"number"`
+	Separator string `json:"separator"`
+}
+
+func runInspect(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	c, args, code := newIDsCommand("inspect", args, stdout, stderr)
+	if c == nil {
+		return code
+	}
+
+	return c.run(args, stdin, func(id string) {
+		in, err := c.inspect(id)
+		if err != nil {
+			c.fail(id, err)
+			return
+		}
+
+		result := inspectResult{
+			ID:        in.ID,
+			Prefix:    string(in.Prefix),
+			Offset:    in.Offset,
+			Increment: in.Increment,
+			Chunks:    make([]chunkResult, len(in.Chunks)),
+			Padding:   in.Padding,
+			Numbers:   in.Numbers,
+			Canonical: in.Canonical,
+		}
+
+		text := fmt.Sprintf("id: %s\nprefix: %c\noffset: %d\nincrement: %d\n", in.ID, in.Prefix, in.Offset, in.Increment)
+
+		for i, chunk := range in.Chunks {
+			result.Chunks[i] = chunkResult{chunk.Start, chunk.Value, chunk.Number, string(chunk.Separator)}
+			text += fmt.Sprintf("chunk: %d %s %d %c\n", chunk.Start, chunk.Value, chunk.Number, chunk.Separator)
+		}
+
+		text += fmt.Sprintf("padding: %s\nnumbers: %s\ncanonical: %s\n", in.Padding, formatNumbers(in.Numbers), in.Canonical)
+
+		c.write(result, text)
+	})
+}
+
+type validateResult struct {
+	ID     string `json:"id"`
+	Valid  bool   `json:"valid"`
+	Reason string `json:"reason,omitempty"`
+}
+
+func runValidate(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	c, args, code := newIDsCommand("validate", args, stdout, stderr)
+	if c == nil {
+		return code
+	}
+
+	return c.run(args, stdin, func(id string) {
+		result := validateResult{ID: id, Valid: true}
+
+		if in, err := c.inspect(id); err != nil {
+			result.Valid, result.Reason = false, err.Error()
+		} else if !in.IsCanonical() {
+			result.Valid, result.Reason = false, "not canonical, expected "+in.Canonical
+		}
+
+		text := id + ": valid"
+		if !result.Valid {
+			text = id + ": invalid: " + result.Reason
+			c.code = exitFailure
+		}
+
+		c.write(result, text)
+	})
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/sqids/sqids-go"
+)
+
+func runImpact(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	const name = "impact"
+
+	fs := newFlagSet(name, stderr)
+	beforeFile := fs.String("before", "", "JSON options file of the current configuration (defaults if empty)")
+	afterFile := fs.String("after", "", "JSON options file of the new configuration (defaults if empty)")
+	from := fs.Uint64("from", 0, "first number of the range")
+	to := fs.Uint64("to", 0, "last number of the range")
+	numbersFile := fs.String("numbers-file", "", `file with one tuple of numbers per line, "-" for stdin, instead of a range`)
+	asJSON := fs.Bool("json", false, "write the report as JSON")
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	before, err := loadOptionsFile(*beforeFile)
+	if err != nil {
+		return fail(stderr, name, err)
+	}
+
+	after, err := loadOptionsFile(*afterFile)
+	if err != nil {
+		return fail(stderr, name, err)
+	}
+
+	var report *sqids.ImpactReport
+
+	if *numbersFile != "" {
+		report, err = analyzeImpactFile(before, after, *numbersFile, stdin)
+	} else {
+		report, err = sqids.AnalyzeImpactRange(before, after, *from, *to)
+	}
+
+	if err != nil {
+		return fail(stderr, name, err)
+	}
+
+	if *asJSON {
+		if err := writeJSON(stdout, report); err != nil {
+			return fail(stderr, name, err)
+		}
+
+		return exitOK
+	}
+
+	fmt.Fprintf(stdout, "checked: %d\n", report.Checked)
+	fmt.Fprintf(stdout, "changed: %d\n", report.ChangedCount)
+	fmt.Fprintf(stdout, "max increment: %d\n", report.MaxIncrement)
+
+	words := make([]string, 0, len(report.Regenerations))
+	for word := range report.Regenerations {
+		words = append(words, word)
+	}
+
+	sort.Slice(words, func(i, j int) bool {
+		if report.Regenerations[words[i]] != report.Regenerations[words[j]] {
+			return report.Regenerations[words[i]] > report.Regenerations[words[j]]
+		}
+
+		return words[i] < words[j]
+	})
+
+	for _, word := range words {
+		fmt.Fprintf(stdout, "regenerations: %s %d\n", word, report.Regenerations[word])
+	}
+
+	if report.ChangedCount > len(report.Changed) {
+		fmt.Fprintf(stdout, "listing the first %d changes\n", len(report.Changed))
+	}
+
+	for _, c := range report.Changed {
+		if c.Err != "" {
+			fmt.Fprintf(stdout, "%s %s -> %s (%s)\n", formatNumbers(c.Numbers), c.Before, c.After, c.Err)
+		} else {
+			fmt.Fprintf(stdout, "%s %s -> %s\n", formatNumbers(c.Numbers), c.Before, c.After)
+		}
+	}
+
+	return exitOK
+}
+
+// analyzeImpactFile streams the tuples of numbers in the file to AnalyzeImpactStream
+func analyzeImpactFile(before, after sqids.Options, path string, stdin io.Reader) (*sqids.ImpactReport, error) {
+	r := stdin
+
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		r = f
+	}
+
+	var (
+		parseErr error
+		tuples   = make(chan []uint64)
+	)
+
+	go func() {
+		defer close(tuples)
+
+		scanner := bufio.NewScanner(r)
+
+		for line := 1; scanner.Scan(); line++ {
+			if strings.TrimSpace(scanner.Text()) == "" {
+				continue
+			}
+
+			numbers, err := parseNumbers(scanner.Text())
+			if err != nil {
+				parseErr = fmt.Errorf("%s:%d: %w", path, line, err)
+				return
+			}
+
+			tuples <- numbers
+		}
+
+		parseErr = scanner.Err()
+	}()
+
+	report, err := sqids.AnalyzeImpactStream(before, after, tuples)
+
+	// drain the tuples if the options were invalid
+	for range tuples {
+	}
+
+	return report, errors.Join(err, parseErr)
+}
+
+// loadOptionsFile reads JSON options, an empty path is the default options
+func loadOptionsFile(path string) (sqids.Options, error) {
+	var o sqids.Options
+
+	if path == "" {
+		return o, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return o, err
+	}
+
+	if err := json.Unmarshal(data, &o); err != nil {
+		return o, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return o, nil
+}
@@ -0,0 +1,80 @@
+// Command sqids works with Sqids IDs from the command line.
+//
+// Usage:
+//
+//	sqids <command> [flags] [arguments]
+//
+// Run "sqids help" for the list of commands.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// Exit codes
+const (
+	exitOK      = 0
+	exitFailure = 1
+	exitUsage   = 2
+)
+
+// command is a subcommand of the sqids command
+type command struct {
+	name  string
+	short string
+	run   func(args []string, stdin io.Reader, stdout, stderr io.Writer) int
+}
+
+var commands = []command{
+	{"encode", "encode numbers into IDs", runEncode},
+	{"decode", "decode IDs into numbers", runDecode},
+	{"inspect", "show how IDs are taken apart while decoding", runInspect},
+	{"validate", "check that IDs decode and are canonical", runValidate},
+	{"convert", "add IDs or numbers to the records of a CSV or JSON lines file", runConvert},
+	{"gen-alphabet", "print a shuffled alphabet from a preset", runGenAlphabet},
+	{"check-config", "check a configuration before releasing it", runCheckConfig},
+	{"analyze-blocklist", "report which blocklist words are kept or dropped", runAnalyzeBlocklist},
+	{"impact", "report which IDs change between two configurations", runImpact},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return exitUsage
+	}
+
+	if args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		usage(stdout)
+		return exitOK
+	}
+
+	for _, c := range commands {
+		if c.name == args[0] {
+			return c.run(args[1:], stdin, stdout, stderr)
+		}
+	}
+
+	fmt.Fprintf(stderr, "sqids: unknown command %q\n", args[0])
+	usage(stderr)
+
+	return exitUsage
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: sqids <command> [flags] [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-20s %s\n", c.name, c.short)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, `Run "sqids <command> -h" for the flags of a command.`)
+}
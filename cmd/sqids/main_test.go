@@ -0,0 +1,346 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sqids/sqids-go"
+)
+
+// runCommand runs the sqids command with the given arguments and stdin
+func runCommand(t *testing.T, stdin string, args ...string) (int, string, string) {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+
+	code := run(args, strings.NewReader(stdin), &stdout, &stderr)
+
+	return code, stdout.String(), stderr.String()
+}
+
+// writeFile writes a file in a temporary directory and returns its path
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestUsage(t *testing.T) {
+	if code, _, stderr := runCommand(t, ""); code != exitUsage || !strings.Contains(stderr, "Usage") {
+		t.Errorf("sqids = %d, %q", code, stderr)
+	}
+
+	if code, _, _ := runCommand(t, "", "unknown"); code != exitUsage {
+		t.Errorf("sqids unknown = %d, want %d", code, exitUsage)
+	}
+
+	if code, stdout, _ := runCommand(t, "", "help"); code != exitOK || !strings.Contains(stdout, "analyze-blocklist") {
+		t.Errorf("sqids help = %d, %q", code, stdout)
+	}
+}
+
+func TestAnalyzeBlocklist(t *testing.T) {
+	path := writeFile(t, "blocklist.txt", "hello\nhellos\nab\nhello\n")
+
+	code, stdout, stderr := runCommand(t, "", "analyze-blocklist", "--blocklist-file", path)
+	if code != exitOK {
+		t.Fatalf("exit code %d: %s", code, stderr)
+	}
+
+	for _, want := range []string{"kept: 2 words", "dropped: ab", "unreachable: hellos (shadowed by hello)"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("output %q does not contain %q", stdout, want)
+		}
+	}
+
+	code, stdout, stderr = runCommand(t, "", "analyze-blocklist", "--alphabet", sqids.AlphabetNumeric, "--json")
+	if code != exitOK {
+		t.Fatalf("exit code %d: %s", code, stderr)
+	}
+
+	var report sqids.BlocklistReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Kept) != 0 || len(report.Dropped) == 0 {
+		t.Errorf("unexpected report: kept %d, dropped %d", len(report.Kept), len(report.Dropped))
+	}
+}
+
+func TestImpact(t *testing.T) {
+	after := writeFile(t, "after.json", `{"blocklist": "default+86Rf07"}`)
+
+	code, stdout, stderr := runCommand(t, "", "impact", "--after", after, "--from", "0", "--to", "100")
+	if code != exitOK {
+		t.Fatalf("exit code %d: %s", code, stderr)
+	}
+
+	if !strings.Contains(stdout, "checked: 101\n") || !strings.Contains(stdout, "changed: 0\n") {
+		t.Errorf("unexpected output %q", stdout)
+	}
+
+	code, stdout, stderr = runCommand(t, "1,2,3\n\n4 5 6\n", "impact", "--after", after, "--numbers-file", "-")
+	if code != exitOK {
+		t.Fatalf("exit code %d: %s", code, stderr)
+	}
+
+	for _, want := range []string{"checked: 2\n", "changed: 1\n", "max increment: 1\n", "regenerations: 86rf07 1\n", "1,2,3 86Rf07 -> se8ojk\n"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("output %q does not contain %q", stdout, want)
+		}
+	}
+
+	if code, _, stderr := runCommand(t, "1,x\n", "impact", "--numbers-file", "-"); code != exitFailure || !strings.Contains(stderr, `-:1: invalid number "x"`) {
+		t.Errorf("exit code %d: %s", code, stderr)
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	if code, stdout, _ := runCommand(t, "", "encode", "1,2,3"); code != exitOK || stdout != "86Rf07\n" {
+		t.Errorf("sqids encode = %d, %q", code, stdout)
+	}
+
+	code, stdout, _ := runCommand(t, "1 2 3\n\n4\n", "encode", "--min-length", "10", "--json")
+	if code != exitOK {
+		t.Fatalf("sqids encode --json = %d", code)
+	}
+
+	s, err := sqids.New(sqids.Options{MinLength: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("sqids encode --json wrote %d lines, want 2", len(lines))
+	}
+
+	var result struct {
+		Numbers []uint64 `json:"numbers"`
+		ID      string   `json:"id"`
+	}
+
+	if err := json.Unmarshal([]byte(lines[1]), &result); err != nil {
+		t.Fatal(err)
+	}
+
+	if id, _ := s.Encode([]uint64{4}); result.ID != id || len(result.Numbers) != 1 {
+		t.Errorf("sqids encode --json = %+v, want %s", result, id)
+	}
+
+	if code, stdout, _ := runCommand(t, "86Rf07\n", "decode"); code != exitOK || stdout != "1,2,3\n" {
+		t.Errorf("sqids decode = %d, %q", code, stdout)
+	}
+
+	if code, stdout, stderr := runCommand(t, "", "decode", "86Rf07", "*"); code != exitFailure || stdout != "1,2,3\n" || stderr == "" {
+		t.Errorf("sqids decode with invalid ID = %d, %q, %q", code, stdout, stderr)
+	}
+
+	if code, _, _ := runCommand(t, "", "encode", "one"); code != exitFailure {
+		t.Errorf("sqids encode one = %d, want %d", code, exitFailure)
+	}
+
+	if code, _, _ := runCommand(t, "", "encode", "--min-length", "256", "1"); code != exitFailure {
+		t.Errorf("sqids encode --min-length 256 = %d, want %d", code, exitFailure)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	code, stdout, _ := runCommand(t, "", "inspect", "--json", "86Rf07")
+	if code != exitOK {
+		t.Fatalf("sqids inspect = %d", code)
+	}
+
+	var result struct {
+		Prefix    string   `json:"prefix"`
+		Chunks    []any    `json:"chunks"`
+		Numbers   []uint64 `json:"numbers"`
+		Canonical string   `json:"canonical"`
+	}
+
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Prefix != "8" || len(result.Chunks) != 3 || len(result.Numbers) != 3 || result.Canonical != "86Rf07" {
+		t.Errorf("sqids inspect = %+v", result)
+	}
+
+	if code, stdout, _ := runCommand(t, "", "inspect", "86Rf07"); code != exitOK || !strings.Contains(stdout, "numbers: 1,2,3") {
+		t.Errorf("sqids inspect = %d, %q", code, stdout)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if code, stdout, _ := runCommand(t, "86Rf07\n", "validate"); code != exitOK || stdout != "86Rf07: valid\n" {
+		t.Errorf("sqids validate = %d, %q", code, stdout)
+	}
+
+	s, err := sqids.New(sqids.Options{MinLength: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	padded, err := s.Encode([]uint64{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code, stdout, _ := runCommand(t, "", "validate", "--json", padded, "*")
+	if code != exitFailure {
+		t.Errorf("sqids validate = %d, want %d", code, exitFailure)
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(stdout), "\n") {
+		var result struct {
+			Valid  bool   `json:"valid"`
+			Reason string `json:"reason"`
+		}
+
+		if err := json.Unmarshal([]byte(line), &result); err != nil {
+			t.Fatal(err)
+		}
+
+		if result.Valid || result.Reason == "" {
+			t.Errorf("sqids validate = %s, want invalid", line)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	csvIn := "user,order\n1,2\n3,4\n"
+
+	code, stdout, _ := runCommand(t, csvIn, "convert", "-encode", "user,order")
+	if code != exitOK {
+		t.Fatalf("sqids convert = %d", code)
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 3 || lines[0] != "user,order,sqid" {
+		t.Fatalf("sqids convert = %q", stdout)
+	}
+
+	id := strings.Split(lines[1], ",")[2]
+
+	decodeIn := "id\n" + id + "\n"
+	if code, stdout, _ := runCommand(t, decodeIn, "convert", "-decode", "id", "-to", "user,order"); code != exitOK || stdout != "id,user,order\n"+id+",1,2\n" {
+		t.Errorf("sqids convert -decode = %d, %q", code, stdout)
+	}
+
+	path := writeFile(t, "in.jsonl", `{"id":"86Rf07","name":"a"}`+"\n\n"+`{"id":"Uk"}`+"\n")
+	want := `{"id":"86Rf07","name":"a","numbers":[1,2,3]}` + "\n" + `{"id":"Uk","numbers":[1]}` + "\n"
+
+	if code, stdout, _ := runCommand(t, "", "convert", "-format", "jsonl", "-input", path, "-decode", "id"); code != exitOK || stdout != want {
+		t.Errorf("sqids convert -format jsonl = %d, %q, want %q", code, stdout, want)
+	}
+
+	if code, stdout, _ := runCommand(t, `{"a":1,"b":"2","c":3}`, "convert", "-format", "jsonl", "-encode", "a,b,c", "-to", "id"); code != exitOK || stdout != `{"a":1,"b":"2","c":3,"id":"86Rf07"}`+"\n" {
+		t.Errorf("sqids convert -format jsonl -encode = %d, %q", code, stdout)
+	}
+
+	if code, _, stderr := runCommand(t, "user\nx\n", "convert", "-encode", "user"); code != exitFailure || !strings.Contains(stderr, "line 2") {
+		t.Errorf("sqids convert with invalid number = %d, %q", code, stderr)
+	}
+
+	if code, _, _ := runCommand(t, csvIn, "convert", "-encode", "user", "-to", "order"); code != exitFailure {
+		t.Errorf("sqids convert to existing column = %d, want %d", code, exitFailure)
+	}
+
+	if code, _, _ := runCommand(t, csvIn, "convert"); code != exitUsage {
+		t.Errorf("sqids convert without columns = %d, want %d", code, exitUsage)
+	}
+}
+
+func TestGenAlphabet(t *testing.T) {
+	code, stdout, _ := runCommand(t, "", "gen-alphabet", "-preset", "crockford", "-seed", "1")
+	if code != exitOK {
+		t.Fatalf("sqids gen-alphabet = %d", code)
+	}
+
+	alphabet := strings.TrimSpace(stdout)
+
+	s, err := sqids.NewWith(sqids.WithAlphabet(sqids.AlphabetCrockford), sqids.WithSeed(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if alphabet != s.Options().Alphabet || alphabet == sqids.AlphabetCrockford {
+		t.Errorf("sqids gen-alphabet = %q, want %q", alphabet, s.Options().Alphabet)
+	}
+
+	if _, err := sqids.New(sqids.Options{Alphabet: alphabet}); err != nil {
+		t.Errorf("sqids gen-alphabet = %q: %v", alphabet, err)
+	}
+
+	if code, _, _ := runCommand(t, "", "gen-alphabet", "-preset", "unknown"); code != exitUsage {
+		t.Errorf("sqids gen-alphabet -preset unknown = %d, want %d", code, exitUsage)
+	}
+}
+
+func TestCheckConfig(t *testing.T) {
+	code, stdout, _ := runCommand(t, "", "check-config", "-strict")
+	if code != exitFailure || !strings.Contains(stdout, "default alphabet") {
+		t.Errorf("sqids check-config = %d, %q", code, stdout)
+	}
+
+	config := writeFile(t, "config.json", `{"alphabet":"abcdefghij","blocklist":["abc","xyz"]}`)
+
+	code, stdout, _ = runCommand(t, "", "check-config", "-json", "-config", config)
+	if code != exitOK {
+		t.Fatalf("sqids check-config = %d", code)
+	}
+
+	var result struct {
+		Fingerprint   string   `json:"fingerprint"`
+		BlocklistSize int      `json:"blocklistSize"`
+		Warnings      []string `json:"warnings"`
+	}
+
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := sqids.New(sqids.Options{Alphabet: "abcdefghij", Blocklist: []string{"abc", "xyz"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Fingerprint != s.Fingerprint() || result.BlocklistSize != 1 || len(result.Warnings) != 2 {
+		t.Errorf("sqids check-config = %+v", result)
+	}
+
+	if strings.Contains(stdout, "abcdefghij") {
+		t.Errorf("sqids check-config should not print the alphabet: %s", stdout)
+	}
+
+	// the default blocklist words the alphabet cannot spell are not warned about
+	crockford := writeFile(t, "crockford.json", `{"alphabet":"`+sqids.AlphabetCrockford+`"}`)
+
+	if code, stdout, _ := runCommand(t, "", "check-config", "-strict", "-config", crockford); code != exitOK {
+		t.Errorf("sqids check-config -strict with the crockford alphabet = %d, %q", code, stdout)
+	}
+
+	// "idiot" can be spelled with digits in leetspeak mode
+	leetspeak := writeFile(t, "leetspeak.json", `{"alphabet":"`+sqids.AlphabetCrockford+`","blocklist":["idiot"],"leetspeakBlocklist":true}`)
+
+	code, stdout, _ = runCommand(t, "", "check-config", "-strict", "-config", leetspeak)
+	if code != exitOK || !strings.Contains(stdout, "blocklist: 1 words\n") {
+		t.Errorf("sqids check-config -strict with leetspeak = %d, %q", code, stdout)
+	}
+
+	t.Setenv("SQIDS_TEST_ALPHABET", "a")
+
+	if code, _, _ := runCommand(t, "", "check-config", "-env-prefix", "SQIDS_TEST"); code != exitFailure {
+		t.Errorf("sqids check-config with invalid alphabet = %d, want %d", code, exitFailure)
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// parseNumbers parses numbers separated by commas or whitespace
+func parseNumbers(line string) ([]uint64, error) {
+	fields := strings.FieldsFunc(line, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+
+	numbers := make([]uint64, 0, len(fields))
+
+	for _, field := range fields {
+		n, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", field)
+		}
+
+		numbers = append(numbers, n)
+	}
+
+	return numbers, nil
+}
+
+// formatNumbers is the reverse of parseNumbers
+func formatNumbers(numbers []uint64) string {
+	fields := make([]string, len(numbers))
+
+	for i, n := range numbers {
+		fields[i] = strconv.FormatUint(n, 10)
+	}
+
+	return strings.Join(fields, ",")
+}
@@ -0,0 +1,223 @@
+package sqids
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// defaultBlocklistRef refers to the default blocklist in serialized options
+const defaultBlocklistRef = "default"
+
+// Configuration errors
+var (
+	ErrBlocklistInvalid = errors.New(`blocklist must be "default", "none" or a list of words`)
+)
+
+// Options returns the effective options of the instance, after validation.
+// The blocklist is the filtered blocklist.
+func (s *Sqids) Options() Options {
+	o := s.options
+	o.Blocklist = append([]string{}, o.Blocklist...)
+	o.Allowlist = append([]string(nil), o.Allowlist...)
+	o.AllowlistNumbers = append([][]uint64(nil), o.AllowlistNumbers...)
+
+	return o
+}
+
+// MarshalJSON encodes the options as JSON.
+//
+// The blocklist is encoded as "default" for the default blocklist, as an
+// empty list for no blocklist, and otherwise as a list of words where
+// a leading "default" entry stands for the words of the default blocklist.
+func (o Options) MarshalJSON() ([]byte, error) {
+	type options Options
+
+	v := struct {
+		options
+		Blocklist any `json:"blocklist"`
+	}{
+		options:   options(o),
+		Blocklist: blocklistRefs(o.Blocklist),
+	}
+
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON decodes options encoded by MarshalJSON. The blocklist may
+// also be given as "default+custom" to append custom words to the
+// default blocklist, or as "none".
+func (o *Options) UnmarshalJSON(data []byte) error {
+	type options Options
+
+	v := struct {
+		*options
+		Blocklist json.RawMessage `json:"blocklist"`
+	}{
+		options: (*options)(o),
+	}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	o.Blocklist = nil
+
+	if len(v.Blocklist) == 0 || string(v.Blocklist) == "null" {
+		return nil
+	}
+
+	var ref string
+	if err := json.Unmarshal(v.Blocklist, &ref); err == nil {
+		blocklist, err := parseBlocklistRef(ref)
+		if err != nil {
+			return err
+		}
+
+		o.Blocklist = blocklist
+
+		return nil
+	}
+
+	var words []string
+	if err := json.Unmarshal(v.Blocklist, &words); err != nil {
+		return ErrBlocklistInvalid
+	}
+
+	o.Blocklist = expandBlocklistRefs(words)
+
+	return nil
+}
+
+// OptionsFromEnv reads options from the environment variables
+// <prefix>_ALPHABET, <prefix>_MIN_LENGTH, <prefix>_FIXED_LENGTH,
+// <prefix>_ALLOW_UNICODE, <prefix>_CASE_INSENSITIVE, <prefix>_BLOCKLIST and
+// <prefix>_LEETSPEAK_BLOCKLIST. The prefix defaults to SQIDS.
+//
+// The blocklist is either "default", "none" or a comma separated list of
+// words, where a "default" entry stands for the default blocklist.
+func OptionsFromEnv(prefix string) (Options, error) {
+	if prefix == "" {
+		prefix = "SQIDS"
+	}
+
+	var o Options
+
+	o.Alphabet = os.Getenv(prefix + "_ALPHABET")
+
+	if v, ok := os.LookupEnv(prefix + "_MIN_LENGTH"); ok {
+		minLength, err := strconv.ParseUint(v, 10, 8)
+		if err != nil {
+			return Options{}, fmt.Errorf("%s_MIN_LENGTH: %w", prefix, err)
+		}
+
+		o.MinLength = uint8(minLength)
+	}
+
+	if v, ok := os.LookupEnv(prefix + "_FIXED_LENGTH"); ok {
+		fixedLength, err := strconv.ParseBool(v)
+		if err != nil {
+			return Options{}, fmt.Errorf("%s_FIXED_LENGTH: %w", prefix, err)
+		}
+
+		o.FixedLength = fixedLength
+	}
+
+	if v, ok := os.LookupEnv(prefix + "_ALLOW_UNICODE"); ok {
+		allowUnicode, err := strconv.ParseBool(v)
+		if err != nil {
+			return Options{}, fmt.Errorf("%s_ALLOW_UNICODE: %w", prefix, err)
+		}
+
+		o.AllowUnicode = allowUnicode
+	}
+
+	if v, ok := os.LookupEnv(prefix + "_CASE_INSENSITIVE"); ok {
+		caseInsensitive, err := strconv.ParseBool(v)
+		if err != nil {
+			return Options{}, fmt.Errorf("%s_CASE_INSENSITIVE: %w", prefix, err)
+		}
+
+		o.CaseInsensitive = caseInsensitive
+	}
+
+	if v, ok := os.LookupEnv(prefix + "_LEETSPEAK_BLOCKLIST"); ok {
+		leetspeakBlocklist, err := strconv.ParseBool(v)
+		if err != nil {
+			return Options{}, fmt.Errorf("%s_LEETSPEAK_BLOCKLIST: %w", prefix, err)
+		}
+
+		o.LeetspeakBlocklist = leetspeakBlocklist
+	}
+
+	if v, ok := os.LookupEnv(prefix + "_BLOCKLIST"); ok {
+		blocklist, err := parseBlocklistRef(strings.ReplaceAll(v, ",", "+"))
+		if err != nil {
+			return Options{}, fmt.Errorf("%s_BLOCKLIST: %w", prefix, err)
+		}
+
+		o.Blocklist = blocklist
+	}
+
+	return o, nil
+}
+
+// parseBlocklistRef parses "default", "none" or words joined by "+",
+// where a "default" word stands for the default blocklist
+func parseBlocklistRef(ref string) ([]string, error) {
+	switch strings.TrimSpace(ref) {
+	case defaultBlocklistRef:
+		return nil, nil
+	case "none", "":
+		return []string{}, nil
+	}
+
+	var words []string
+
+	for _, word := range strings.Split(ref, "+") {
+		if word = strings.TrimSpace(word); word == "" {
+			return nil, ErrBlocklistInvalid
+		}
+
+		words = append(words, word)
+	}
+
+	return expandBlocklistRefs(words), nil
+}
+
+// expandBlocklistRefs replaces "default" entries with the default blocklist
+func expandBlocklistRefs(refs []string) []string {
+	words := []string{}
+
+	for _, ref := range refs {
+		if ref == defaultBlocklistRef {
+			words = append(words, defaultBlocklist...)
+		} else {
+			words = append(words, ref)
+		}
+	}
+
+	return words
+}
+
+// blocklistRefs is the reverse of expandBlocklistRefs
+func blocklistRefs(blocklist []string) any {
+	if blocklist == nil {
+		return defaultBlocklistRef
+	}
+
+	if len(blocklist) >= len(defaultBlocklist) {
+		for i, word := range defaultBlocklist {
+			if blocklist[i] != word {
+				return blocklist
+			}
+		}
+
+		return append([]string{defaultBlocklistRef}, blocklist[len(defaultBlocklist):]...)
+	}
+
+	return blocklist
+}
@@ -0,0 +1,123 @@
+package sqids
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestOptionsJSON(t *testing.T) {
+	for _, o := range []Options{
+		{},
+		{Alphabet: "0123456789abcdef", MinLength: 10, FixedLength: true},
+		{Blocklist: []string{}},
+		{Blocklist: []string{"foo", "bar"}},
+		{Blocklist: Blocklist("foo", "bar")},
+	} {
+		data, err := json.Marshal(o)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got Options
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(got, o) {
+			t.Errorf("%s round-tripped to %+v, want %+v", data, got, o)
+		}
+	}
+}
+
+func TestOptionsJSONBlocklistRefs(t *testing.T) {
+	for _, tt := range []struct {
+		json string
+		want []string
+	}{
+		{`{}`, nil},
+		{`{"blocklist":null}`, nil},
+		{`{"blocklist":"default"}`, nil},
+		{`{"blocklist":"none"}`, []string{}},
+		{`{"blocklist":[]}`, []string{}},
+		{`{"blocklist":"default+foo+bar"}`, Blocklist("foo", "bar")},
+		{`{"blocklist":["default","foo"]}`, Blocklist("foo")},
+		{`{"blocklist":["foo"]}`, []string{"foo"}},
+	} {
+		var o Options
+		if err := json.Unmarshal([]byte(tt.json), &o); err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(o.Blocklist, tt.want) {
+			t.Errorf("%s decoded to blocklist of %d words, want %d", tt.json, len(o.Blocklist), len(tt.want))
+		}
+	}
+
+	if data, _ := json.Marshal(Options{Blocklist: Blocklist("foo")}); string(data) != `{"blocklist":["default","foo"]}` {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+
+	var o Options
+	if err := json.Unmarshal([]byte(`{"blocklist":42}`), &o); err != ErrBlocklistInvalid {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestOptionsFromEnv(t *testing.T) {
+	t.Setenv("SQIDS_ALPHABET", "0123456789abcdef")
+	t.Setenv("SQIDS_MIN_LENGTH", "10")
+	t.Setenv("SQIDS_FIXED_LENGTH", "true")
+	t.Setenv("SQIDS_BLOCKLIST", "default,foo")
+
+	o, err := OptionsFromEnv("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Options{
+		Alphabet:    "0123456789abcdef",
+		MinLength:   10,
+		FixedLength: true,
+		Blocklist:   Blocklist("foo"),
+	}
+
+	if !reflect.DeepEqual(o, want) {
+		t.Errorf("OptionsFromEnv() = %+v, want %+v", o, want)
+	}
+
+	t.Setenv("APP_MIN_LENGTH", "256")
+
+	if _, err := OptionsFromEnv("APP"); err == nil {
+		t.Errorf("OptionsFromEnv should fail on a min length out of range")
+	}
+}
+
+func TestSqidsOptions(t *testing.T) {
+	s, err := New(Options{
+		MinLength: 10,
+		Blocklist: []string{"FOO", "ab"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o := s.Options()
+
+	if o.Alphabet != defaultAlphabet {
+		t.Errorf("Alphabet = %q, want %q", o.Alphabet, defaultAlphabet)
+	}
+
+	if !reflect.DeepEqual(o.Blocklist, []string{"foo"}) {
+		t.Errorf("Blocklist = %v, want [foo]", o.Blocklist)
+	}
+
+	other, err := New(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !s.Equal(other) {
+		t.Errorf("New(s.Options()) should be equal to s")
+	}
+}
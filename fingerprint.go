@@ -0,0 +1,114 @@
+package sqids
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"log/slog"
+	"sort"
+)
+
+// Fingerprint returns a stable hash of the shuffled alphabet, min length,
+// fixed length, leetspeak and case-insensitive modes, normalizer, filtered
+// blocklist and allowlist. Instances with the same fingerprint generate and
+// decode the same IDs.
+func (s *Sqids) Fingerprint() string {
+	h := sha256.New()
+
+	h.Write([]byte(s.alphabet))
+	h.Write([]byte{0, s.minLength})
+
+	// the order of the blocklist does not change which IDs are blocked
+	words := append([]string(nil), s.blocklist...)
+	sort.Strings(words)
+
+	for _, word := range words {
+		binary.Write(h, binary.BigEndian, uint32(len(word)))
+		h.Write([]byte(word))
+	}
+
+	// the allowlist changes which IDs are generated, but most instances
+	// have none and keep the fingerprint they had before allowlists
+	if len(s.allowlist) > 0 || len(s.allowlistNumbers) > 0 {
+		h.Write([]byte{0})
+
+		for _, key := range sortedKeys(s.allowlist) {
+			h.Write([]byte(key + "\x00"))
+		}
+
+		h.Write([]byte{0})
+
+		for _, key := range sortedKeys(s.allowlistNumbers) {
+			h.Write([]byte(key + "\x00"))
+		}
+	}
+
+	// modes that change which IDs are generated or decoded, hashed only
+	// when set so that instances without them keep their fingerprint
+	if s.fixedLength {
+		h.Write([]byte("\x00fixedLength"))
+	}
+
+	if s.leetspeak {
+		h.Write([]byte("\x00leetspeak"))
+	}
+
+	if s.caseFold != nil {
+		h.Write([]byte("\x00caseInsensitive"))
+	}
+
+	if n := s.normalizer; n != nil {
+		h.Write([]byte("\x00normalizer"))
+
+		confusables := make([]rune, 0, len(n.Confusables))
+		for r := range n.Confusables {
+			confusables = append(confusables, r)
+		}
+
+		sort.Slice(confusables, func(i, j int) bool {
+			return confusables[i] < confusables[j]
+		})
+
+		for _, r := range confusables {
+			binary.Write(h, binary.BigEndian, [2]int32{r, n.Confusables[r]})
+		}
+
+		binary.Write(h, binary.BigEndian, uint32(len(n.Separators)))
+		h.Write([]byte(n.Separators))
+		binary.Write(h, binary.BigEndian, [2]bool{n.StripSpace, n.FoldCase})
+	}
+
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Equal reports whether both instances have the same fingerprint
+func (s *Sqids) Equal(other *Sqids) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return s.Fingerprint() == other.Fingerprint()
+}
+
+// LogValue implements slog.LogValuer, logging the configuration
+// without revealing the alphabet
+func (s *Sqids) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("alphabet_length", len([]rune(s.alphabet))),
+		slog.String("fingerprint", s.Fingerprint()),
+		slog.Int("min_length", int(s.minLength)),
+		slog.Bool("fixed_length", s.fixedLength),
+		slog.Int("blocklist_size", len(s.blocklist)),
+	)
+}
@@ -0,0 +1,147 @@
+package sqids
+
+import "testing"
+
+func TestFingerprint(t *testing.T) {
+	s, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		options Options
+		equal   bool
+	}{
+		{Options{}, true},
+		{Options{Alphabet: defaultAlphabet, Blocklist: Blocklist()}, true},
+		{Options{Blocklist: append([]string{"zzzz"}, Blocklist()...)}, false},
+		{Options{MinLength: 10}, false},
+		{Options{Alphabet: "FxnXM1kBN6cuhsAvjW3Co7l2RePyY8DwaU04Tzt9fHQrqSVKdpimLGIJOgb5ZE"}, false},
+		{Options{Blocklist: []string{}}, false},
+	} {
+		other, err := New(tt.options)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := s.Fingerprint() == other.Fingerprint(); got != tt.equal {
+			t.Errorf("fingerprints of %+v equal = %v, want %v", tt.options, got, tt.equal)
+		}
+
+		if got := s.Equal(other); got != tt.equal {
+			t.Errorf("Equal(%+v) = %v, want %v", tt.options, got, tt.equal)
+		}
+	}
+}
+
+func TestFingerprintBlocklistOrder(t *testing.T) {
+	a, err := New(Options{Blocklist: []string{"abc", "def"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := New(Options{Blocklist: []string{"DEF", "abc"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !a.Equal(b) {
+		t.Errorf("fingerprints %s and %s should be equal", a.Fingerprint(), b.Fingerprint())
+	}
+}
+
+func TestEqualNil(t *testing.T) {
+	s, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Equal(nil) {
+		t.Errorf("Equal(nil) should be false")
+	}
+}
+
+func TestFingerprintFixedLength(t *testing.T) {
+	a, err := New(Options{MinLength: 8})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := New(Options{MinLength: 8, FixedLength: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.Equal(b) {
+		t.Errorf("fingerprints with and without fixed length should differ, both are %s", a.Fingerprint())
+	}
+}
+
+func TestFingerprintLeetspeak(t *testing.T) {
+	a, err := New(Options{Blocklist: []string{"idiot"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := New(Options{Blocklist: []string{"idiot"}, LeetspeakBlocklist: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.Equal(b) {
+		t.Errorf("fingerprints with and without leetspeak should differ, both are %s", a.Fingerprint())
+	}
+}
+
+func TestFingerprintCaseInsensitive(t *testing.T) {
+	a, err := New(Options{Alphabet: AlphabetLowerAlnum})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := New(Options{Alphabet: AlphabetLowerAlnum, CaseInsensitive: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.Equal(b) {
+		t.Errorf("fingerprints with and without case insensitivity should differ, both are %s", a.Fingerprint())
+	}
+}
+
+func TestFingerprintNormalizer(t *testing.T) {
+	a, err := New(Options{Alphabet: AlphabetCrockford})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := New(Options{Alphabet: AlphabetCrockford, Normalizer: UnambiguousNormalizer()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := New(Options{Alphabet: AlphabetCrockford, Normalizer: UnambiguousNormalizer()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.Equal(b) {
+		t.Errorf("fingerprints with and without a normalizer should differ, both are %s", a.Fingerprint())
+	}
+
+	if !b.Equal(c) {
+		t.Errorf("fingerprints with the same normalizer should be equal, got %s and %s", b.Fingerprint(), c.Fingerprint())
+	}
+
+	n := UnambiguousNormalizer()
+	n.FoldCase = false
+
+	d, err := New(Options{Alphabet: AlphabetCrockford, Normalizer: n})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b.Equal(d) {
+		t.Errorf("fingerprints with different normalizers should differ, both are %s", b.Fingerprint())
+	}
+}
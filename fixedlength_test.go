@@ -0,0 +1,104 @@
+package sqids
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFixedLength(t *testing.T) {
+	s, err := New(Options{
+		MinLength:   10,
+		FixedLength: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, numbers := range [][]uint64{
+		{0},
+		{1, 2, 3},
+		{1000000},
+		{s.MaxValueForLength(10, 2), s.MaxValueForLength(10, 2)},
+	} {
+		generatedID, err := s.Encode(numbers)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(generatedID) != 10 {
+			t.Errorf("Encoding `%v` should produce `10` length, but produced `%v` length instead", numbers, len(generatedID))
+		}
+
+		decodedNumbers := s.Decode(generatedID)
+		if !reflect.DeepEqual(numbers, decodedNumbers) {
+			t.Errorf("Decoding `%v` should produce `%v`, but instead produced `%v`", generatedID, numbers, decodedNumbers)
+		}
+	}
+}
+
+func TestFixedLengthExceeded(t *testing.T) {
+	s, err := New(Options{
+		MinLength:   10,
+		FixedLength: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, numbers := range [][]uint64{
+		{maxUint64Value},
+		{0, 0, 0, 0, 0, 0},
+		{s.MaxValueForLength(10, 2) + 1, s.MaxValueForLength(10, 2) + 1},
+	} {
+		if _, err := s.Encode(numbers); err != ErrFixedLengthExceeded {
+			t.Errorf("Encoding `%v` should fail with `%v`, but instead got `%v`", numbers, ErrFixedLengthExceeded, err)
+		}
+
+		if got := s.EncodedLength(numbers); got != -1 {
+			t.Errorf("EncodedLength(%v) = %d, want -1", numbers, got)
+		}
+	}
+
+	if got := s.EncodedLength([]uint64{1, 2, 3}); got != 10 {
+		t.Errorf("EncodedLength([1 2 3]) = %d, want 10", got)
+	}
+}
+
+func TestFixedLengthWithoutMinLength(t *testing.T) {
+	_, err := New(Options{
+		FixedLength: true,
+	})
+
+	if !errors.Is(err, ErrFixedLengthWithoutMinLength) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestMaxValueForLength(t *testing.T) {
+	s, err := New(Options{
+		Alphabet: "0123456789a",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		n     int
+		count int
+		want  uint64
+	}{
+		{1, 1, 0},
+		{2, 1, 9},
+		{3, 1, 99},
+		{4, 2, 9},
+		{7, 2, 99},
+		{6, 3, 9},
+		{30, 1, maxUint64Value},
+		{10, 0, 0},
+	} {
+		if got := s.MaxValueForLength(tt.n, tt.count); got != tt.want {
+			t.Errorf("MaxValueForLength(%d, %d) = %d, want %d", tt.n, tt.count, got, tt.want)
+		}
+	}
+}
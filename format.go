@@ -0,0 +1,48 @@
+package sqids
+
+import (
+	"errors"
+	"strings"
+)
+
+// Formatting errors
+var (
+	ErrSeparatorInAlphabet = errors.New("separator must not be in the alphabet")
+	ErrGroupSizeTooSmall   = errors.New("group size must be at least 1")
+)
+
+// Format splits the id into groups of groupSize characters joined by sep,
+// for displaying IDs like X7K2-9QPL-M4TD. The last group may be shorter.
+func (s *Sqids) Format(id string, groupSize int, sep rune) (string, error) {
+	if groupSize < 1 {
+		return "", ErrGroupSizeTooSmall
+	}
+
+	if strings.ContainsRune(s.alphabet, sep) {
+		return "", ErrSeparatorInAlphabet
+	}
+
+	var (
+		b   strings.Builder
+		rid = []rune(id)
+	)
+
+	for i, r := range rid {
+		if i > 0 && i%groupSize == 0 {
+			b.WriteRune(sep)
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String(), nil
+}
+
+// ParseFormatted decodes an id formatted with Format, ignoring every sep
+func (s *Sqids) ParseFormatted(formatted string, sep rune) ([]uint64, error) {
+	if strings.ContainsRune(s.alphabet, sep) {
+		return nil, ErrSeparatorInAlphabet
+	}
+
+	return s.decodeObserved(strings.ReplaceAll(formatted, string(sep), ""), nil)
+}
@@ -0,0 +1,90 @@
+package sqids
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	s, err := New(Options{
+		Alphabet:  AlphabetCrockford,
+		MinLength: 12,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	numbers := []uint64{1, 2, 3}
+
+	id, err := s.Encode(numbers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	formatted, err := s.Format(id, 4, '-')
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := id[:4] + "-" + id[4:8] + "-" + id[8:]; formatted != want {
+		t.Errorf("Format(%q, 4, '-') = %q, want %q", id, formatted, want)
+	}
+
+	decodedNumbers, err := s.ParseFormatted(formatted, '-')
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(numbers, decodedNumbers) {
+		t.Errorf("Decoding `%v` should produce `%v`, but instead produced `%v`", formatted, numbers, decodedNumbers)
+	}
+}
+
+func TestFormatUneven(t *testing.T) {
+	s, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		groupSize int
+		want      string
+	}{
+		{1, "8 6 R f 0 7"},
+		{4, "86Rf 07"},
+		{6, "86Rf07"},
+		{10, "86Rf07"},
+	} {
+		got, err := s.Format("86Rf07", tt.groupSize, ' ')
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != tt.want {
+			t.Errorf("Format(%q, %d, ' ') = %q, want %q", "86Rf07", tt.groupSize, got, tt.want)
+		}
+	}
+}
+
+func TestFormatErrors(t *testing.T) {
+	s, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Format("86Rf07", 0, '-'); err != ErrGroupSizeTooSmall {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if _, err := s.Format("86Rf07", 2, 'x'); err != ErrSeparatorInAlphabet {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if _, err := s.ParseFormatted("86R-f07", 'x'); err != ErrSeparatorInAlphabet {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if _, err := s.ParseFormatted("86R_f07", '-'); err != ErrIDInvalidCharacter {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
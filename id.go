@@ -0,0 +1,61 @@
+package sqids
+
+import "log/slog"
+
+// ID is an encoded ID together with the numbers it encodes
+type ID struct {
+	id         string
+	numbers    []uint64
+	logNumbers bool
+}
+
+// NewID encodes the numbers into an ID
+func (s *Sqids) NewID(numbers []uint64) (ID, error) {
+	id, err := s.Encode(numbers)
+	if err != nil {
+		return ID{}, err
+	}
+
+	return ID{
+		id:         id,
+		numbers:    numbers,
+		logNumbers: s.logNumbers,
+	}, nil
+}
+
+// ParseID decodes the id string into an ID
+func (s *Sqids) ParseID(id string) (ID, error) {
+	numbers, err := s.decodeObserved(id, nil)
+	if err != nil {
+		return ID{}, err
+	}
+
+	return ID{
+		id:         id,
+		numbers:    numbers,
+		logNumbers: s.logNumbers,
+	}, nil
+}
+
+// String returns the encoded ID
+func (id ID) String() string {
+	return id.id
+}
+
+// Numbers returns the numbers encoded in the ID
+func (id ID) Numbers() []uint64 {
+	return id.numbers
+}
+
+// LogValue implements slog.LogValuer, logging the numbers only
+// if LogNumbers was set in the Options
+func (id ID) LogValue() slog.Value {
+	if !id.logNumbers {
+		return slog.StringValue(id.id)
+	}
+
+	return slog.GroupValue(
+		slog.String("sqid", id.id),
+		slog.Any("numbers", id.numbers),
+	)
+}
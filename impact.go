@@ -0,0 +1,253 @@
+package sqids
+
+import (
+	"errors"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// MaxImpactChanges is the number of changed tuples an ImpactReport lists,
+// so that analyzing large ranges does not run out of memory
+const MaxImpactChanges = 10000
+
+// ImpactReport describes how IDs change between two configurations,
+// see AnalyzeImpact
+type ImpactReport struct {
+	// Checked is the number of number tuples that were encoded
+	Checked int `json:"checked"`
+
+	// ChangedCount is the number of tuples whose IDs differ
+	ChangedCount int `json:"changedCount"`
+
+	// Changed are the first MaxImpactChanges tuples whose IDs differ,
+	// in input order
+	Changed []ImpactChange `json:"changed"`
+
+	// Regenerations counts, per blocklist word, how often the word forced
+	// the new configuration to re-generate an ID
+	Regenerations map[string]int `json:"regenerations"`
+
+	// MaxIncrement is the highest increment the new configuration reached
+	MaxIncrement int `json:"maxIncrement"`
+}
+
+// ImpactChange is a tuple of numbers whose ID differs
+type ImpactChange struct {
+	Numbers []uint64 `json:"numbers"`
+	Before  string   `json:"before"`
+	After   string   `json:"after"`
+
+	// Err is set if encoding failed with either configuration
+	Err string `json:"err,omitempty"`
+
+	index int
+}
+
+// AnalyzeImpact encodes the tuples of numbers with both options and
+// reports the IDs that differ, encoding in parallel on all CPUs
+func AnalyzeImpact(before, after Options, tuples [][]uint64) (*ImpactReport, error) {
+	return analyzeImpact(before, after, func(jobs chan<- impactJob) {
+		for i, numbers := range tuples {
+			jobs <- impactJob{index: i, numbers: numbers}
+		}
+	})
+}
+
+// AnalyzeImpactRange is AnalyzeImpact for the single numbers from
+// and to, inclusive
+func AnalyzeImpactRange(before, after Options, from, to uint64) (*ImpactReport, error) {
+	return analyzeImpact(before, after, func(jobs chan<- impactJob) {
+		if from > to {
+			return
+		}
+
+		for n, i := from, 0; ; n, i = n+1, i+1 {
+			jobs <- impactJob{index: i, numbers: []uint64{n}}
+
+			// stop before n overflows when to is math.MaxUint64
+			if n == to {
+				return
+			}
+		}
+	})
+}
+
+// AnalyzeImpactStream is AnalyzeImpact for tuples read from a channel,
+// such as tuples read from a file. It returns once the channel is closed.
+func AnalyzeImpactStream(before, after Options, tuples <-chan []uint64) (*ImpactReport, error) {
+	return analyzeImpact(before, after, func(jobs chan<- impactJob) {
+		i := 0
+		for numbers := range tuples {
+			jobs <- impactJob{index: i, numbers: numbers}
+			i++
+		}
+	})
+}
+
+type impactJob struct {
+	index   int
+	numbers []uint64
+}
+
+func analyzeImpact(before, after Options, feed func(chan<- impactJob)) (*ImpactReport, error) {
+	b, err := New(before)
+	if err != nil {
+		return nil, err
+	}
+
+	o := &impactObserver{
+		next:  after.Observer,
+		words: make(map[string]int),
+	}
+	after.Observer = o
+
+	a, err := New(after)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg           sync.WaitGroup
+		mu           sync.Mutex
+		checked      int
+		changedCount int
+		changed      []ImpactChange
+		jobs         = make(chan impactJob, 1024)
+	)
+
+	for w := 0; w < runtime.GOMAXPROCS(0); w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			var (
+				n, count int
+				found    []ImpactChange
+			)
+
+			for job := range jobs {
+				n++
+
+				change := ImpactChange{Numbers: job.numbers, index: job.index}
+
+				beforeID, beforeErr := b.Encode(job.numbers)
+				afterID, afterErr := a.Encode(job.numbers)
+
+				change.Before, change.After = beforeID, afterID
+
+				if beforeID == afterID && sameError(beforeErr, afterErr) {
+					continue
+				}
+
+				switch {
+				case beforeErr != nil:
+					change.Err = beforeErr.Error()
+				case afterErr != nil:
+					change.Err = afterErr.Error()
+				}
+
+				count++
+				found = append(found, change)
+
+				if len(found) == 2*MaxImpactChanges {
+					found = firstImpactChanges(found)
+				}
+			}
+
+			mu.Lock()
+			checked += n
+			changedCount += count
+			changed = append(changed, firstImpactChanges(found)...)
+			mu.Unlock()
+		}()
+	}
+
+	feed(jobs)
+	close(jobs)
+	wg.Wait()
+
+	changed = firstImpactChanges(changed)
+
+	if changed == nil {
+		changed = []ImpactChange{}
+	}
+
+	return &ImpactReport{
+		Checked:       checked,
+		ChangedCount:  changedCount,
+		Changed:       changed,
+		Regenerations: o.words,
+		MaxIncrement:  int(o.maxIncrement.Load()),
+	}, nil
+}
+
+// sameError reports whether both encodes failed the same way, or neither failed
+func sameError(a, b error) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return errors.Is(a, b) || a.Error() == b.Error()
+}
+
+// firstImpactChanges sorts the changes in input order and keeps the
+// first MaxImpactChanges
+func firstImpactChanges(changes []ImpactChange) []ImpactChange {
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].index < changes[j].index
+	})
+
+	if len(changes) > MaxImpactChanges {
+		changes = changes[:MaxImpactChanges:MaxImpactChanges]
+	}
+
+	return changes
+}
+
+// impactObserver counts the blocklist words that force regenerations,
+// and passes events on to the observer of the analyzed options
+type impactObserver struct {
+	next Observer
+
+	mu           sync.Mutex
+	words        map[string]int
+	maxIncrement atomic.Int64
+}
+
+func (o *impactObserver) OnEncode(e EncodeEvent) {
+	if o.next != nil {
+		o.next.OnEncode(e)
+	}
+}
+
+func (o *impactObserver) OnDecode(e DecodeEvent) {
+	if o.next != nil {
+		o.next.OnDecode(e)
+	}
+}
+
+func (o *impactObserver) OnBlocklistHit(e BlocklistHitEvent) {
+	o.mu.Lock()
+	o.words[e.Word]++
+	o.mu.Unlock()
+
+	if o.next != nil {
+		o.next.OnBlocklistHit(e)
+	}
+}
+
+func (o *impactObserver) OnRegenerate(e RegenerateEvent) {
+	for {
+		current := o.maxIncrement.Load()
+		if int64(e.Increment) <= current || o.maxIncrement.CompareAndSwap(current, int64(e.Increment)) {
+			break
+		}
+	}
+
+	if o.next != nil {
+		o.next.OnRegenerate(e)
+	}
+}
@@ -0,0 +1,143 @@
+package sqids
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAnalyzeImpact(t *testing.T) {
+	report, err := AnalyzeImpact(
+		Options{},
+		Options{Blocklist: Blocklist("86Rf07")},
+		[][]uint64{{1, 2, 3}, {4, 5, 6}, {1, 2, 3}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Checked != 3 {
+		t.Errorf("Checked = %d, want 3", report.Checked)
+	}
+
+	want := []ImpactChange{
+		{Numbers: []uint64{1, 2, 3}, Before: "86Rf07", After: "se8ojk", index: 0},
+		{Numbers: []uint64{1, 2, 3}, Before: "86Rf07", After: "se8ojk", index: 2},
+	}
+
+	if !reflect.DeepEqual(report.Changed, want) {
+		t.Errorf("Changed = %+v, want %+v", report.Changed, want)
+	}
+
+	if got := report.Regenerations; !reflect.DeepEqual(got, map[string]int{"86rf07": 2}) {
+		t.Errorf("Regenerations = %v, want map[86rf07:2]", got)
+	}
+
+	if report.MaxIncrement != 1 {
+		t.Errorf("MaxIncrement = %d, want 1", report.MaxIncrement)
+	}
+}
+
+func TestAnalyzeImpactRange(t *testing.T) {
+	s, err := New(Options{Blocklist: []string{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// block the IDs of 1000 and 2000
+	var blocklist []string
+	for _, n := range []uint64{1000, 2000} {
+		id, err := s.Encode([]uint64{n})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		blocklist = append(blocklist, id)
+	}
+
+	report, err := AnalyzeImpactRange(Options{Blocklist: []string{}}, Options{Blocklist: blocklist}, 0, 2999)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Checked != 3000 {
+		t.Errorf("Checked = %d, want 3000", report.Checked)
+	}
+
+	if len(report.Changed) != 2 || report.Changed[0].Numbers[0] != 1000 || report.Changed[1].Numbers[0] != 2000 {
+		t.Errorf("Changed = %+v, want the IDs of 1000 and 2000", report.Changed)
+	}
+}
+
+func TestAnalyzeImpactStream(t *testing.T) {
+	tuples := make(chan []uint64)
+
+	go func() {
+		for n := uint64(0); n < 100; n++ {
+			tuples <- []uint64{n, n}
+		}
+		close(tuples)
+	}()
+
+	report, err := AnalyzeImpactStream(Options{}, Options{}, tuples)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Checked != 100 || len(report.Changed) != 0 {
+		t.Errorf("Checked = %d, Changed = %d, want 100 and 0", report.Checked, len(report.Changed))
+	}
+}
+
+func TestAnalyzeImpactMaxUint64(t *testing.T) {
+	report, err := AnalyzeImpactRange(Options{}, Options{}, maxUint64Value-1, maxUint64Value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Checked != 2 {
+		t.Errorf("Checked = %d, want 2", report.Checked)
+	}
+}
+
+func TestAnalyzeImpactInvalidOptions(t *testing.T) {
+	if _, err := AnalyzeImpact(Options{}, Options{Alphabet: "ab"}, nil); err == nil {
+		t.Errorf("AnalyzeImpact should fail on invalid options")
+	}
+}
+
+func TestAnalyzeImpactMaxChanges(t *testing.T) {
+	to := uint64(3*MaxImpactChanges - 1)
+
+	report, err := AnalyzeImpactRange(Options{}, Options{Alphabet: AlphabetLowerAlnum}, 0, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.ChangedCount != int(to)+1 {
+		t.Errorf("ChangedCount = %d, want %d", report.ChangedCount, to+1)
+	}
+
+	if len(report.Changed) != MaxImpactChanges {
+		t.Fatalf("len(Changed) = %d, want %d", len(report.Changed), MaxImpactChanges)
+	}
+
+	for i, c := range report.Changed {
+		if c.Numbers[0] != uint64(i) {
+			t.Fatalf("Changed[%d] = %v, want the first changes in input order", i, c.Numbers)
+		}
+	}
+}
+
+func TestAnalyzeImpactSameErrors(t *testing.T) {
+	// most of these numbers do not fit into 3 characters
+	o := Options{MinLength: 3, FixedLength: true}
+
+	report, err := AnalyzeImpactRange(o, o, 0, 10000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.ChangedCount != 0 {
+		t.Errorf("ChangedCount = %d, want 0 for identical options", report.ChangedCount)
+	}
+}
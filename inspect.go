@@ -0,0 +1,99 @@
+package sqids
+
+// Inspection describes how an ID was taken apart while decoding it
+type Inspection struct {
+	// ID is the inspected ID
+	ID string
+
+	// Normalized is the ID after normalization and case folding, the
+	// positions of the chunks and padding refer to it
+	Normalized string
+
+	// Prefix is the first character of the ID, selecting the alphabet offset
+	Prefix rune
+
+	// Offset is the position of the prefix in the shuffled alphabet
+	Offset int
+
+	// Increment is the number of times the ID had to be re-generated
+	// because of the blocklist, inferred from the offset
+	Increment int
+
+	// Chunks are the encoded numbers in the order they appear in the ID
+	Chunks []Chunk
+
+	// PaddingStart is the rune index where padding starts, or -1 if the ID
+	// is not padded
+	PaddingStart int
+
+	// Padding is the padding portion of the ID, including its leading separator
+	Padding string
+
+	// Numbers are the decoded numbers, the same as returned by Decode
+	Numbers []uint64
+
+	// Canonical is the ID that Encode generates for Numbers
+	Canonical string
+}
+
+// Chunk is a single encoded number within an ID
+type Chunk struct {
+	// Start is the rune index of the chunk in the ID
+	Start int
+
+	// Value is the encoded number
+	Value string
+
+	// Number is the decoded number
+	Number uint64
+
+	// Separator is the character that separates this chunk from the
+	// next chunk or the padding
+	Separator rune
+}
+
+// Inspect decodes id the same way as Decode, and reports the alphabet
+// offset, chunks, padding and increment that make up the ID
+func (s *Sqids) Inspect(id string) (*Inspection, error) {
+	in := &Inspection{
+		ID:           id,
+		PaddingStart: -1,
+	}
+
+	numbers, err := s.decodeObserved(id, in)
+	if err != nil {
+		return nil, err
+	}
+
+	in.Numbers = numbers
+
+	if n := len(in.Chunks); n > 0 {
+		last := in.Chunks[n-1]
+
+		normalized := []rune(in.Normalized)
+
+		if end := last.Start + len([]rune(last.Value)); end < len(normalized) {
+			in.PaddingStart = end
+			in.Padding = string(normalized[end:])
+		}
+
+		size := len([]rune(s.alphabet))
+		in.Increment = (in.Offset - calculateOffset(s.alphabet, numbers, 0) + size) % size
+	}
+
+	if in.Canonical, err = s.encode(numbers); err != nil {
+		return nil, err
+	}
+
+	return in, nil
+}
+
+// IsCanonical reports whether the inspected ID, after normalization and
+// case folding, is the one Encode generates for its numbers
+func (in *Inspection) IsCanonical() bool {
+	if in.Normalized != "" {
+		return in.Normalized == in.Canonical
+	}
+
+	return in.ID == in.Canonical
+}
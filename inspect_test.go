@@ -0,0 +1,193 @@
+package sqids
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestInspect(t *testing.T) {
+	s, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in, err := s.Inspect("86Rf07")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := in.Numbers, []uint64{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Numbers = %v, want %v", got, want)
+	}
+
+	if got, want := in.Prefix, '8'; got != want {
+		t.Errorf("Prefix = %q, want %q", got, want)
+	}
+
+	if got, want := in.Increment, 0; got != want {
+		t.Errorf("Increment = %d, want %d", got, want)
+	}
+
+	if got, want := in.PaddingStart, -1; got != want {
+		t.Errorf("PaddingStart = %d, want %d", got, want)
+	}
+
+	var chunks []string
+	for _, c := range in.Chunks {
+		chunks = append(chunks, c.Value)
+	}
+
+	if got, want := chunks, []string{"6", "f", "7"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Chunks = %v, want %v", got, want)
+	}
+
+	if !in.IsCanonical() {
+		t.Errorf("%q should be canonical, but re-encodes to %q", in.ID, in.Canonical)
+	}
+}
+
+func TestInspectPadding(t *testing.T) {
+	s, err := New(Options{
+		MinLength: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in, err := s.Inspect("86Rf07xd4z")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := in.PaddingStart, 6; got != want {
+		t.Errorf("PaddingStart = %d, want %d", got, want)
+	}
+
+	if got, want := in.Padding, "xd4z"; got != want {
+		t.Errorf("Padding = %q, want %q", got, want)
+	}
+
+	if got, want := in.Chunks[len(in.Chunks)-1].Separator, 'x'; got != want {
+		t.Errorf("Separator = %q, want %q", got, want)
+	}
+}
+
+func TestInspectIncrement(t *testing.T) {
+	s, err := New(Options{
+		Blocklist: []string{"86Rf07"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in, err := s.Inspect("se8ojk")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := in.Increment, 1; got != want {
+		t.Errorf("Increment = %d, want %d", got, want)
+	}
+
+	if !in.IsCanonical() {
+		t.Errorf("%q should be canonical, but re-encodes to %q", in.ID, in.Canonical)
+	}
+}
+
+func TestInspectNonCanonical(t *testing.T) {
+	s, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in, err := s.Inspect("86Rf07xd4z")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if in.IsCanonical() {
+		t.Errorf("%q should not be canonical", in.ID)
+	}
+
+	if got, want := in.Canonical, "86Rf07"; got != want {
+		t.Errorf("Canonical = %q, want %q", got, want)
+	}
+}
+
+func TestInspectInvalidCharacter(t *testing.T) {
+	s, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Inspect("86Rf07!"); err != ErrIDInvalidCharacter {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestInspectNormalized(t *testing.T) {
+	s, err := New(Options{
+		Alphabet:   AlphabetCrockford,
+		Normalizer: UnambiguousNormalizer(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id, err := s.Encode([]uint64{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in, err := s.Inspect("  " + id[:2] + "-" + id[2:] + "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := in.Normalized, id; got != want {
+		t.Errorf("Normalized = %q, want %q", got, want)
+	}
+
+	if in.PaddingStart != -1 || in.Padding != "" {
+		t.Errorf("PaddingStart, Padding = %d, %q, want -1, \"\"", in.PaddingStart, in.Padding)
+	}
+}
+
+func TestInspectObserver(t *testing.T) {
+	counter := &Counter{}
+
+	s, err := New(Options{Observer: counter})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Inspect("86Rf07"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := counter.Totals(); got.Encodes != 0 || got.Decodes != 1 {
+		t.Errorf("Inspect counted %d encodes and %d decodes, want 0 and 1", got.Encodes, got.Decodes)
+	}
+}
+
+func TestInspectCanonicalNormalized(t *testing.T) {
+	s, err := New(Options{CaseInsensitive: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id, err := s.Encode([]uint64{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in, err := s.Inspect(strings.ToUpper(id))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !in.IsCanonical() {
+		t.Errorf("%q should be canonical, but re-encodes to %q", in.ID, in.Canonical)
+	}
+}
@@ -0,0 +1,142 @@
+package sqids
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLeetspeakBlocklist(t *testing.T) {
+	s, err := New(Options{
+		Blocklist:          []string{"IDIOT"},
+		LeetspeakBlocklist: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{"idiot", "1d10t", "1d1ot", "1di0t", "1diot", "id10t", "id1ot", "idi0t", "XidiotX", "1D10Tx", "x1d10t"} {
+		if !s.isBlockedID(id) {
+			t.Errorf("%q should be blocked", id)
+		}
+	}
+
+	// variants with digits only match at the start or end, like in the
+	// default blocklist, and l is not read as i
+	for _, id := range []string{"idio", "xyz123", "Xid10tX", "ldlot", "xldlotx"} {
+		if s.isBlockedID(id) {
+			t.Errorf("%q should not be blocked", id)
+		}
+	}
+}
+
+func TestLeetspeakBlocklistRules(t *testing.T) {
+	s, err := New(Options{
+		Blocklist:          []string{"palle", "b00b"},
+		LeetspeakBlocklist: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		id   string
+		word string
+		rule string
+	}{
+		{"xpallex", "palle", BlockRuleSubstring},
+		{"pa11ex", "palle", BlockRulePrefix},
+		{"xp4lle", "palle", BlockRuleSuffix},
+		{"xp4llex", "", ""},
+		{"xpaiiex", "", ""},
+		{"boobxx", "boob", BlockRuleSubstring},
+		{"xxb00b", "boob", BlockRuleSuffix},
+		{"xb00bx", "", ""},
+	} {
+		word, rule, _ := s.BlockedBy(tt.id)
+		if word != tt.word || rule != tt.rule {
+			t.Errorf("BlockedBy(%q) = %q, %q, want %q, %q", tt.id, word, rule, tt.word, tt.rule)
+		}
+	}
+}
+
+func TestLeetspeakBlocklistBaseForms(t *testing.T) {
+	// the default blocklist reduced to its base forms
+	var base []string
+	seen := make(map[string]bool)
+
+	for _, word := range defaultBlocklist {
+		if w := leetspeak(word); !seen[w] {
+			seen[w] = true
+			base = append(base, w)
+		}
+	}
+
+	if len(base) >= len(defaultBlocklist) {
+		t.Fatalf("base forms should be fewer than the %d default words, got %d", len(defaultBlocklist), len(base))
+	}
+
+	full, err := New(Options{LeetspeakBlocklist: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := New(Options{Blocklist: base, LeetspeakBlocklist: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(s.blocklist, full.blocklist) {
+		t.Errorf("base forms should filter to the same blocklist as the default blocklist")
+	}
+
+	for _, word := range defaultBlocklist {
+		if !s.isBlockedID(word) {
+			t.Errorf("%q should be blocked by the base forms", word)
+		}
+	}
+}
+
+func TestLeetspeakBlocklistAlphabet(t *testing.T) {
+	// "idiot" can only be spelled with digits in this alphabet
+	s, err := New(Options{
+		Alphabet:           "01dt23456789",
+		Blocklist:          []string{"idiot", "hello"},
+		LeetspeakBlocklist: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(s.blocklist, []string{"idiot"}) {
+		t.Errorf("blocklist = %v, want [idiot]", s.blocklist)
+	}
+}
+
+func TestLeetspeakBlocklistEncoding(t *testing.T) {
+	s, err := New(Options{
+		LeetspeakBlocklist: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, numbers := range [][]uint64{
+		{1, 2, 3},
+		{1000000},
+		{maxUint64Value},
+	} {
+		generatedID, err := s.Encode(numbers)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if s.isBlockedID(generatedID) {
+			t.Errorf("Encoding `%v` produced the blocked `%v`", numbers, generatedID)
+		}
+
+		decodedNumbers := s.Decode(generatedID)
+		if !reflect.DeepEqual(numbers, decodedNumbers) {
+			t.Errorf("Decoding `%v` should produce `%v`, but instead produced `%v`", generatedID, numbers, decodedNumbers)
+		}
+	}
+}
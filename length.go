@@ -0,0 +1,171 @@
+package sqids
+
+import (
+	"math"
+	"math/bits"
+)
+
+// EncodedLength returns the length of the ID that Encode would generate
+// for the given numbers, without generating it. In fixed length mode it
+// returns -1 if the ID would not fit, where Encode fails with
+// ErrFixedLengthExceeded.
+func (s *Sqids) EncodedLength(numbers []uint64) int {
+	if len(numbers) == 0 {
+		return 0
+	}
+
+	// one prefix plus a separator between numbers
+	length := len(numbers)
+
+	for _, num := range numbers {
+		length += digits(num, s.base())
+	}
+
+	if s.fixedLength && length > int(s.minLength) {
+		return -1
+	}
+
+	return max(length, int(s.minLength))
+}
+
+// MaxEncodedLength returns the length of the longest ID that can be
+// generated from count numbers
+func (s *Sqids) MaxEncodedLength(count int) int {
+	if count <= 0 {
+		return 0
+	}
+
+	return max(count+count*digits(math.MaxUint64, s.base()), int(s.minLength))
+}
+
+// CountIDsOfLength returns how many tuples of count numbers are encoded
+// into an ID of exactly n characters, ignoring the blocklist. The result
+// saturates at math.MaxUint64.
+func (s *Sqids) CountIDsOfLength(n int, count int) uint64 {
+	minLength := int(s.minLength)
+
+	if count <= 0 || n < 2*count || n < minLength || (s.fixedLength && n != minLength) {
+		return 0
+	}
+
+	var (
+		base      = s.base()
+		maxDigits = digits(math.MaxUint64, base)
+
+		// one prefix plus a separator between numbers leaves n-count
+		// characters for the numbers
+		length = n - count
+
+		// ways[l] is how many tuples of the numbers so far take l characters
+		ways = []uint64{1}
+	)
+
+	for i := 0; i < count; i++ {
+		next := make([]uint64, min(len(ways)+maxDigits, length+1))
+
+		for l, w := range ways {
+			for d := 1; d <= maxDigits && l+d <= length; d++ {
+				next[l+d] = saturatingAdd(next[l+d], saturatingMul(w, valuesWithDigits(d, base)))
+			}
+		}
+
+		ways = next
+	}
+
+	if n > minLength {
+		if length >= len(ways) {
+			// longer than count numbers can get
+			return 0
+		}
+
+		return ways[length]
+	}
+
+	// every tuple short enough is padded to the minimum length
+	total := uint64(0)
+	for _, w := range ways {
+		total = saturatingAdd(total, w)
+	}
+
+	return total
+}
+
+// MaxValueForLength returns the largest value that count numbers can each
+// take while still being encoded into an ID of at most n characters.
+//
+// An ID needs at least two characters per number, so if n is smaller than
+// 2*count nothing fits and the returned value (zero) should not be relied on.
+func (s *Sqids) MaxValueForLength(n int, count int) uint64 {
+	if count <= 0 || n < 2*count {
+		return 0
+	}
+
+	// one prefix plus a separator between numbers leaves n-count characters
+	return maxValueForDigits((n-count)/count, s.base())
+}
+
+// base is the radix used by toID, the prefix is never part of a number
+func (s *Sqids) base() uint64 {
+	return uint64(len([]rune(s.alphabet)) - 1)
+}
+
+// digits returns how many characters toID needs for num
+func digits(num uint64, base uint64) int {
+	d := 1
+
+	for num >= base {
+		num /= base
+		d++
+	}
+
+	return d
+}
+
+// valuesWithDigits returns how many numbers toID encodes in exactly d characters
+func valuesWithDigits(d int, base uint64) uint64 {
+	upper := maxValueForDigits(d, base)
+	if d == 1 {
+		return upper + 1
+	}
+
+	lower := maxValueForDigits(d-1, base)
+	if lower == math.MaxUint64 {
+		return 0
+	}
+
+	return upper - lower
+}
+
+func saturatingAdd(a, b uint64) uint64 {
+	sum, carry := bits.Add64(a, b, 0)
+	if carry != 0 {
+		return math.MaxUint64
+	}
+
+	return sum
+}
+
+func saturatingMul(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	if hi != 0 {
+		return math.MaxUint64
+	}
+
+	return lo
+}
+
+// maxValueForDigits returns the largest number toID encodes in at most d
+// characters, saturating at math.MaxUint64
+func maxValueForDigits(d int, base uint64) uint64 {
+	limit := uint64(1)
+
+	for i := 0; i < d; i++ {
+		if limit > math.MaxUint64/base {
+			return math.MaxUint64
+		}
+
+		limit *= base
+	}
+
+	return limit - 1
+}
@@ -0,0 +1,145 @@
+package sqids
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEncodedLength(t *testing.T) {
+	for _, minLength := range []uint8{0, 5, 10, uint8(len(defaultAlphabet))} {
+		s, err := New(Options{
+			MinLength: minLength,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, numbers := range [][]uint64{
+			{},
+			{minUint64Value},
+			{0, 0, 0, 0, 0},
+			{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			{100, 200, 300},
+			{1000000},
+			{maxUint64Value},
+			{maxUint64Value, maxUint64Value},
+		} {
+			generatedID, err := s.Encode(numbers)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := s.EncodedLength(numbers), len(generatedID); got != want {
+				t.Errorf("EncodedLength(%v) = %d, want %d", numbers, got, want)
+			}
+		}
+
+		for count := 1; count <= 3; count++ {
+			numbers := make([]uint64, count)
+			for i := range numbers {
+				numbers[i] = maxUint64Value
+			}
+
+			generatedID, err := s.Encode(numbers)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := s.MaxEncodedLength(count), len(generatedID); got != want {
+				t.Errorf("MaxEncodedLength(%d) = %d, want %d", count, got, want)
+			}
+		}
+	}
+}
+
+func TestCountIDsOfLength(t *testing.T) {
+	s, err := New(Options{
+		Alphabet: "abc",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		n    int
+		want uint64
+	}{
+		{1, 0},
+		{2, 2},
+		{3, 2},
+		{4, 4},
+		{5, 8},
+		{65, 1 << 63},
+		{66, 0},
+	} {
+		if got := s.CountIDsOfLength(tt.n, 1); got != tt.want {
+			t.Errorf("CountIDsOfLength(%d) = %d, want %d", tt.n, got, tt.want)
+		}
+	}
+
+	s, err = New(Options{
+		Alphabet:  "abc",
+		MinLength: 4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		n    int
+		want uint64
+	}{
+		{3, 0},
+		{4, 8},
+		{5, 8},
+	} {
+		if got := s.CountIDsOfLength(tt.n, 1); got != tt.want {
+			t.Errorf("CountIDsOfLength(%d) = %d, want %d", tt.n, got, tt.want)
+		}
+	}
+
+	s, err = New(Options{
+		MinLength: uint8(len(defaultAlphabet)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := s.CountIDsOfLength(len(defaultAlphabet), 1), uint64(math.MaxUint64); got != want {
+		t.Errorf("CountIDsOfLength(%d) = %d, want %d", len(defaultAlphabet), got, want)
+	}
+}
+
+func TestCountIDsOfLengthTuples(t *testing.T) {
+	for _, minLength := range []uint8{0, 5} {
+		s, err := New(Options{
+			Alphabet:  "abcde",
+			MinLength: minLength,
+			Blocklist: []string{},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// with a base of 4, numbers below 64 take at most 3 characters,
+		// which covers every pair in IDs of up to 6 characters
+		counts := make(map[int]uint64)
+
+		for a := uint64(0); a < 64; a++ {
+			for b := uint64(0); b < 64; b++ {
+				id, err := s.Encode([]uint64{a, b})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				counts[len(id)]++
+			}
+		}
+
+		for n := 0; n <= 6; n++ {
+			if got, want := s.CountIDsOfLength(n, 2), counts[n]; got != want {
+				t.Errorf("min length %d: CountIDsOfLength(%d, 2) = %d, want %d", minLength, n, got, want)
+			}
+		}
+	}
+}
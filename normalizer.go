@@ -0,0 +1,83 @@
+package sqids
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Normalizer cleans up IDs entered by people before they are decoded.
+//
+// Characters that are in the alphabet are never changed by the
+// confusables map or by case folding.
+type Normalizer struct {
+	// Confusables maps characters to the character they are mistaken for
+	Confusables map[rune]rune
+
+	// Separators are characters that are removed, such as hyphens
+	Separators string
+
+	// StripSpace removes whitespace
+	StripSpace bool
+
+	// FoldCase replaces characters that are not in the alphabet by their
+	// upper or lower case form if that is in the alphabet
+	FoldCase bool
+}
+
+// UnambiguousNormalizer returns a Normalizer for alphabets that keep 0 and 1
+// but not their lookalikes, such as AlphabetCrockford. It reads O and o as 0,
+// I, i, L and l as 1, folds case and ignores spaces and hyphens.
+func UnambiguousNormalizer() *Normalizer {
+	return &Normalizer{
+		Confusables: map[rune]rune{
+			'O': '0',
+			'o': '0',
+			'I': '1',
+			'i': '1',
+			'L': '1',
+			'l': '1',
+		},
+		Separators: "-",
+		StripSpace: true,
+		FoldCase:   true,
+	}
+}
+
+// Normalize returns the id as it would be decoded, after applying the
+// normalizer (if any)
+func (s *Sqids) Normalize(id string) string {
+	if s.normalizer == nil {
+		return id
+	}
+
+	return s.normalizer.normalize(id, []rune(s.alphabet))
+}
+
+func (n *Normalizer) normalize(id string, alphabet []rune) string {
+	var b strings.Builder
+
+	for _, r := range id {
+		if contains(alphabet, r) {
+			b.WriteRune(r)
+			continue
+		}
+
+		if n.StripSpace && unicode.IsSpace(r) || strings.ContainsRune(n.Separators, r) {
+			continue
+		}
+
+		if c, ok := n.Confusables[r]; ok && contains(alphabet, c) {
+			r = c
+		} else if n.FoldCase {
+			if c := unicode.ToLower(r); contains(alphabet, c) {
+				r = c
+			} else if c := unicode.ToUpper(r); contains(alphabet, c) {
+				r = c
+			}
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
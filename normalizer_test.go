@@ -0,0 +1,87 @@
+package sqids
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestUnambiguousNormalizer(t *testing.T) {
+	s, err := New(Options{
+		Alphabet:   AlphabetCrockford,
+		MinLength:  12,
+		Normalizer: UnambiguousNormalizer(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// these encode to IDs containing a 0 and a 1
+	for _, numbers := range [][]uint64{{14}, {20}} {
+		generatedID, err := s.Encode(numbers)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		typed := strings.NewReplacer("0", "O", "1", "l").Replace(generatedID)
+		typed = " " + typed[:4] + "-" + typed[4:8] + " - " + typed[8:] + "\n"
+
+		if got := s.Normalize(typed); got != generatedID {
+			t.Errorf("Normalize(%q) = %q, want %q", typed, got, generatedID)
+		}
+
+		decodedNumbers := s.Decode(typed)
+		if !reflect.DeepEqual(numbers, decodedNumbers) {
+			t.Errorf("Decoding `%v` should produce `%v`, but instead produced `%v`", typed, numbers, decodedNumbers)
+		}
+
+		lower := strings.NewReplacer("1", "i").Replace(strings.ToLower(generatedID))
+		upper := strings.NewReplacer("1", "L").Replace(generatedID)
+
+		for _, typed := range []string{lower, upper} {
+			decodedNumbers := s.Decode(typed)
+			if !reflect.DeepEqual(numbers, decodedNumbers) {
+				t.Errorf("Decoding `%v` should produce `%v`, but instead produced `%v`", typed, numbers, decodedNumbers)
+			}
+		}
+	}
+}
+
+func TestNormalizerFoldCase(t *testing.T) {
+	s, err := New(Options{
+		Alphabet:   AlphabetCrockford,
+		Normalizer: &Normalizer{FoldCase: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	numbers := []uint64{1, 2, 3}
+
+	generatedID, err := s.Encode(numbers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decodedNumbers := s.Decode(strings.ToLower(generatedID))
+	if !reflect.DeepEqual(numbers, decodedNumbers) {
+		t.Errorf("Decoding `%v` should produce `%v`, but instead produced `%v`", strings.ToLower(generatedID), numbers, decodedNumbers)
+	}
+}
+
+func TestNormalizerKeepsAlphabet(t *testing.T) {
+	s, err := New(Options{
+		Normalizer: &Normalizer{
+			Confusables: map[rune]rune{'O': '0'},
+			Separators:  "-",
+			FoldCase:    true,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := s.Normalize("Oo0-!"), "Oo0!"; got != want {
+		t.Errorf("Normalize() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,130 @@
+package sqids
+
+import (
+	"encoding/json"
+	"sync/atomic"
+	"time"
+)
+
+// Observer is notified by Sqids as IDs are encoded and decoded.
+// Implementations must be safe for concurrent use.
+type Observer interface {
+	OnEncode(EncodeEvent)
+	OnDecode(DecodeEvent)
+	OnBlocklistHit(BlocklistHitEvent)
+	OnRegenerate(RegenerateEvent)
+}
+
+// EncodeEvent is passed to Observer.OnEncode after every call to Encode
+type EncodeEvent struct {
+	Numbers  []uint64
+	ID       string
+	Err      error
+	Duration time.Duration
+}
+
+// DecodeEvent is passed to Observer.OnDecode after every call to Decode
+type DecodeEvent struct {
+	ID       string
+	Numbers  []uint64
+	Err      error
+	Duration time.Duration
+}
+
+// BlocklistHitEvent is passed to Observer.OnBlocklistHit when a generated
+// ID matches a blocklist word
+type BlocklistHitEvent struct {
+	ID        string
+	Word      string
+	Increment int
+}
+
+// RegenerateEvent is passed to Observer.OnRegenerate when an ID is
+// re-generated with a higher increment because of the blocklist
+type RegenerateEvent struct {
+	Numbers   []uint64
+	Increment int
+}
+
+// Counter is an Observer that keeps running totals.
+//
+// Counter implements the expvar.Var interface, so it can be published
+// as is with expvar.Publish.
+type Counter struct {
+	encodes       atomic.Uint64
+	encodeErrors  atomic.Uint64
+	decodes       atomic.Uint64
+	decodeErrors  atomic.Uint64
+	blocklistHits atomic.Uint64
+	regenerations atomic.Uint64
+	maxIncrement  atomic.Uint64
+}
+
+// CounterTotals is a snapshot of the totals kept by a Counter
+type CounterTotals struct {
+	Encodes       uint64 `json:"encodes"`
+	EncodeErrors  uint64 `json:"encode_errors"`
+	Decodes       uint64 `json:"decodes"`
+	DecodeErrors  uint64 `json:"decode_errors"`
+	BlocklistHits uint64 `json:"blocklist_hits"`
+	Regenerations uint64 `json:"regenerations"`
+
+	// MaxIncrement is the highest increment reached, encoding fails
+	// with an error once it exceeds the alphabet length
+	MaxIncrement uint64 `json:"max_increment"`
+}
+
+// OnEncode counts an encode
+func (c *Counter) OnEncode(e EncodeEvent) {
+	c.encodes.Add(1)
+
+	if e.Err != nil {
+		c.encodeErrors.Add(1)
+	}
+}
+
+// OnDecode counts a decode
+func (c *Counter) OnDecode(e DecodeEvent) {
+	c.decodes.Add(1)
+
+	if e.Err != nil {
+		c.decodeErrors.Add(1)
+	}
+}
+
+// OnBlocklistHit counts a blocklist hit
+func (c *Counter) OnBlocklistHit(BlocklistHitEvent) {
+	c.blocklistHits.Add(1)
+}
+
+// OnRegenerate counts a regeneration and keeps track of the highest increment
+func (c *Counter) OnRegenerate(e RegenerateEvent) {
+	c.regenerations.Add(1)
+
+	for {
+		current := c.maxIncrement.Load()
+		if uint64(e.Increment) <= current || c.maxIncrement.CompareAndSwap(current, uint64(e.Increment)) {
+			return
+		}
+	}
+}
+
+// Totals returns a snapshot of the totals
+func (c *Counter) Totals() CounterTotals {
+	return CounterTotals{
+		Encodes:       c.encodes.Load(),
+		EncodeErrors:  c.encodeErrors.Load(),
+		Decodes:       c.decodes.Load(),
+		DecodeErrors:  c.decodeErrors.Load(),
+		BlocklistHits: c.blocklistHits.Load(),
+		Regenerations: c.regenerations.Load(),
+		MaxIncrement:  c.maxIncrement.Load(),
+	}
+}
+
+// String returns the totals as JSON
+func (c *Counter) String() string {
+	b, _ := json.Marshal(c.Totals())
+
+	return string(b)
+}
@@ -0,0 +1,104 @@
+package sqids
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCounter(t *testing.T) {
+	c := &Counter{}
+
+	s, err := New(Options{
+		Blocklist: []string{"86Rf07"},
+		Observer:  c,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Encode([]uint64{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	s.Decode("se8ojk")
+	s.Decode("se8ojk!")
+
+	want := CounterTotals{
+		Encodes:       1,
+		Decodes:       2,
+		DecodeErrors:  1,
+		BlocklistHits: 1,
+		Regenerations: 1,
+		MaxIncrement:  1,
+	}
+
+	if got := c.Totals(); got != want {
+		t.Errorf("Totals() = %+v, want %+v", got, want)
+	}
+
+	var totals CounterTotals
+	if err := json.Unmarshal([]byte(c.String()), &totals); err != nil {
+		t.Fatal(err)
+	}
+
+	if totals != want {
+		t.Errorf("String() = %s, want %+v", c.String(), want)
+	}
+}
+
+type recordingObserver struct {
+	words []string
+}
+
+func (r *recordingObserver) OnEncode(EncodeEvent)         {}
+func (r *recordingObserver) OnDecode(DecodeEvent)         {}
+func (r *recordingObserver) OnRegenerate(RegenerateEvent) {}
+
+func (r *recordingObserver) OnBlocklistHit(e BlocklistHitEvent) {
+	r.words = append(r.words, e.Word)
+}
+
+func TestObserverBlocklistWord(t *testing.T) {
+	r := &recordingObserver{}
+
+	s, err := New(Options{
+		Blocklist: []string{"86Rf07"},
+		Observer:  r,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Encode([]uint64{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(r.words) != 1 || r.words[0] != "86rf07" {
+		t.Errorf("blocklist hits = %v, want [86rf07]", r.words)
+	}
+}
+
+func TestObserverParse(t *testing.T) {
+	counter := &Counter{}
+
+	s, err := New(Options{Observer: counter})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.ParseID("86Rf07"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.ParseFormatted("86R-f07", '-'); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.ParseID("*"); err == nil {
+		t.Fatal("ParseID(\"*\") should fail")
+	}
+
+	if got := counter.Totals(); got.Decodes != 3 || got.DecodeErrors != 1 {
+		t.Errorf("Totals() = %+v, want 3 decodes and 1 decode error", got)
+	}
+}
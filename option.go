@@ -0,0 +1,223 @@
+package sqids
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrOptionConflict is returned by NewWith when options contradict each other
+var ErrOptionConflict = errors.New("conflicting options")
+
+// Option configures an instance of Sqids created with NewWith
+type Option func(*config) error
+
+// config collects the options given to NewWith
+type config struct {
+	options Options
+
+	alphabetSet      bool
+	minLengthSet     bool
+	blocklistSet     bool
+	withoutBlocklist bool
+	seedSet          bool
+	seed             uint64
+}
+
+// NewWith constructs an instance of Sqids from functional options.
+//
+// Options may be given in any order, giving the same option twice with
+// different values is an error wrapping ErrOptionConflict.
+func NewWith(opts ...Option) (*Sqids, error) {
+	c := &config{}
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.seedSet {
+		c.options.Alphabet = seededShuffle(c.options.alphabet(), c.seed)
+	}
+
+	return New(c.options)
+}
+
+// WithAlphabet sets the alphabet
+func WithAlphabet(alphabet string) Option {
+	return func(c *config) error {
+		if c.alphabetSet && c.options.Alphabet != alphabet {
+			return fmt.Errorf("%w: alphabet given twice", ErrOptionConflict)
+		}
+
+		c.alphabetSet = true
+		c.options.Alphabet = alphabet
+
+		return nil
+	}
+}
+
+// WithMinLength sets the minimum length of IDs
+func WithMinLength(minLength uint8) Option {
+	return func(c *config) error {
+		if c.minLengthSet && c.options.MinLength != minLength {
+			return fmt.Errorf("%w: min length given as %d and %d", ErrOptionConflict, c.options.MinLength, minLength)
+		}
+
+		c.minLengthSet = true
+		c.options.MinLength = minLength
+
+		return nil
+	}
+}
+
+// WithFixedLength sets the exact length of IDs, see Options.FixedLength
+func WithFixedLength(length uint8) Option {
+	return func(c *config) error {
+		if err := WithMinLength(length)(c); err != nil {
+			return err
+		}
+
+		c.options.FixedLength = true
+
+		return nil
+	}
+}
+
+// WithBlocklist replaces the default blocklist with the given words.
+// Giving it more than once adds to the blocklist.
+func WithBlocklist(words ...string) Option {
+	return func(c *config) error {
+		if c.withoutBlocklist {
+			return fmt.Errorf("%w: blocklist given together with WithoutBlocklist", ErrOptionConflict)
+		}
+
+		c.blocklistSet = true
+		c.options.Blocklist = append(append([]string{}, c.options.Blocklist...), words...)
+
+		return nil
+	}
+}
+
+// WithoutBlocklist disables the blocklist
+func WithoutBlocklist() Option {
+	return func(c *config) error {
+		if c.blocklistSet {
+			return fmt.Errorf("%w: blocklist given together with WithoutBlocklist", ErrOptionConflict)
+		}
+
+		c.withoutBlocklist = true
+		c.options.Blocklist = []string{}
+
+		return nil
+	}
+}
+
+// WithSeed shuffles the alphabet with the given seed, so that the same
+// alphabet generates different IDs for different seeds
+func WithSeed(seed uint64) Option {
+	return func(c *config) error {
+		if c.seedSet && c.seed != seed {
+			return fmt.Errorf("%w: seed given as %d and %d", ErrOptionConflict, c.seed, seed)
+		}
+
+		c.seedSet = true
+		c.seed = seed
+
+		return nil
+	}
+}
+
+// WithUnicode allows multibyte characters in the alphabet, see Options.AllowUnicode
+func WithUnicode() Option {
+	return func(c *config) error {
+		c.options.AllowUnicode = true
+
+		return nil
+	}
+}
+
+// WithCaseInsensitive decodes IDs regardless of their case, see Options.CaseInsensitive
+func WithCaseInsensitive() Option {
+	return func(c *config) error {
+		c.options.CaseInsensitive = true
+
+		return nil
+	}
+}
+
+// WithNormalizer cleans up IDs before they are decoded, see Options.Normalizer
+func WithNormalizer(normalizer *Normalizer) Option {
+	return func(c *config) error {
+		c.options.Normalizer = normalizer
+
+		return nil
+	}
+}
+
+// WithLeetspeakBlocklist matches the blocklist regardless of leetspeak,
+// see Options.LeetspeakBlocklist
+func WithLeetspeakBlocklist() Option {
+	return func(c *config) error {
+		c.options.LeetspeakBlocklist = true
+
+		return nil
+	}
+}
+
+// WithAllowlist adds IDs that are never blocked, see Options.Allowlist
+func WithAllowlist(ids ...string) Option {
+	return func(c *config) error {
+		c.options.Allowlist = append(append([]string{}, c.options.Allowlist...), ids...)
+
+		return nil
+	}
+}
+
+// WithAllowlistNumbers adds numbers whose IDs are never blocked,
+// see Options.AllowlistNumbers
+func WithAllowlistNumbers(numbers ...[]uint64) Option {
+	return func(c *config) error {
+		c.options.AllowlistNumbers = append(append([][]uint64{}, c.options.AllowlistNumbers...), numbers...)
+
+		return nil
+	}
+}
+
+// WithObserver sets the observer, see Options.Observer
+func WithObserver(observer Observer) Option {
+	return func(c *config) error {
+		c.options.Observer = observer
+
+		return nil
+	}
+}
+
+// WithLogNumbers logs the numbers of IDs, see Options.LogNumbers
+func WithLogNumbers() Option {
+	return func(c *config) error {
+		c.options.LogNumbers = true
+
+		return nil
+	}
+}
+
+// seededShuffle deterministically shuffles the alphabet using
+// a splitmix64 sequence started from the seed
+func seededShuffle(alphabet string, seed uint64) string {
+	runes := []rune(alphabet)
+
+	for i := len(runes) - 1; i > 0; i-- {
+		seed += 0x9e3779b97f4a7c15
+
+		z := seed
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		z ^= z >> 31
+
+		j := int(z % uint64(i+1))
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+
+	return string(runes)
+}
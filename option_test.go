@@ -0,0 +1,110 @@
+package sqids
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewWith(t *testing.T) {
+	s, err := NewWith()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !s.Equal(d) {
+		t.Errorf("NewWith() should be equal to New()")
+	}
+
+	s, err = NewWith(
+		WithAlphabet("0123456789abcdef"),
+		WithMinLength(10),
+		WithBlocklist("abc"),
+		WithBlocklist("def"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o, err := New(Options{
+		Alphabet:  "0123456789abcdef",
+		MinLength: 10,
+		Blocklist: []string{"abc", "def"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !s.Equal(o) {
+		t.Errorf("NewWith should be equal to New with the same Options")
+	}
+}
+
+func TestNewWithZeroMinLength(t *testing.T) {
+	if _, err := NewWith(WithMinLength(0), WithMinLength(10)); !errors.Is(err, ErrOptionConflict) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestNewWithConflicts(t *testing.T) {
+	for _, opts := range [][]Option{
+		{WithAlphabet("abc"), WithAlphabet("def")},
+		{WithMinLength(5), WithMinLength(6)},
+		{WithMinLength(5), WithFixedLength(6)},
+		{WithBlocklist("abc"), WithoutBlocklist()},
+		{WithoutBlocklist(), WithBlocklist("abc")},
+		{WithSeed(1), WithSeed(2)},
+	} {
+		if _, err := NewWith(opts...); !errors.Is(err, ErrOptionConflict) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+
+	if _, err := NewWith(WithMinLength(5), WithMinLength(5), WithFixedLength(5)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestNewWithSeed(t *testing.T) {
+	a, err := NewWith(WithSeed(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := NewWith(WithSeed(42), WithAlphabet(defaultAlphabet))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := NewWith(WithSeed(43))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !a.Equal(b) {
+		t.Errorf("the same seed should produce the same instance")
+	}
+
+	if a.Equal(c) {
+		t.Errorf("different seeds should produce different instances")
+	}
+
+	if got, want := a.Options().Alphabet, seededShuffle(defaultAlphabet, 42); got != want {
+		t.Errorf("Alphabet = %q, want %q", got, want)
+	}
+}
+
+func TestNewWithoutBlocklist(t *testing.T) {
+	s, err := NewWith(WithoutBlocklist())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(s.blocklist) != 0 {
+		t.Errorf("blocklist should be empty, has %d words", len(s.blocklist))
+	}
+}
@@ -0,0 +1,90 @@
+package sqids
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func logJSON(t *testing.T, key string, value any) map[string]any {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("test", key, value)
+
+	var record map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatal(err)
+	}
+
+	return record
+}
+
+func TestIDLogValue(t *testing.T) {
+	s, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id, err := s.NewID([]uint64{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := logJSON(t, "id", id)["id"], "86Rf07"; got != want {
+		t.Errorf("logged id = %v, want %v", got, want)
+	}
+}
+
+func TestIDLogValueNumbers(t *testing.T) {
+	s, err := New(Options{
+		LogNumbers: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id, err := s.ParseID("86Rf07")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	group, ok := logJSON(t, "id", id)["id"].(map[string]any)
+	if !ok {
+		t.Fatalf("logged id should be a group")
+	}
+
+	if got, want := group["sqid"], "86Rf07"; got != want {
+		t.Errorf("logged sqid = %v, want %v", got, want)
+	}
+
+	if got, want := len(group["numbers"].([]any)), 3; got != want {
+		t.Errorf("logged numbers = %v, want %d numbers", group["numbers"], want)
+	}
+}
+
+func TestSqidsLogValue(t *testing.T) {
+	s, err := New(Options{
+		MinLength: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("test", "sqids", s)
+
+	if strings.Contains(buf.String(), s.alphabet) || strings.Contains(buf.String(), defaultAlphabet) {
+		t.Errorf("logged configuration reveals the alphabet: %s", buf.String())
+	}
+
+	for _, want := range []string{"sqids.alphabet_length=62", "sqids.min_length=10", "sqids.fingerprint=" + s.Fingerprint()} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("logged configuration %q does not contain %q", buf.String(), want)
+		}
+	}
+}
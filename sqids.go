@@ -4,36 +4,114 @@ package sqids
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
 	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 const (
 	defaultAlphabet   = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
 	minAlphabetLength = 3
+
+	// defaultCaseInsensitiveAlphabet is the default alphabet in case-insensitive mode
+	defaultCaseInsensitiveAlphabet = AlphabetLowerAlnum
 )
 
 var defaultBlocklist []string = newDefaultBlocklist()
 
 // Alphabet validation errors
 var (
-	errAlphabetMultibyte       = errors.New("alphabet must not contain any multibyte characters")
-	errAlphabetTooShort        = errors.New("alphabet length must be at least 3")
-	errAlphabetNotUniqueChars  = errors.New("alphabet must contain unique characters")
-	errMaxRegenerationAttempts = errors.New("reached max attempts to re-generate the id")
+	ErrAlphabetMultibyte       = errors.New("alphabet must not contain any multibyte characters")
+	ErrAlphabetTooShort        = errors.New("alphabet length must be at least 3")
+	ErrAlphabetNotUniqueChars  = errors.New("alphabet must contain unique characters")
+	ErrAlphabetCaseConflict    = errors.New("case-insensitive alphabet must not contain characters that differ only by case")
+	ErrMaxRegenerationAttempts = errors.New("reached max attempts to re-generate the id")
+)
+
+// Decoding errors
+var (
+	ErrIDInvalidCharacter = errors.New("id must only contain characters from the alphabet")
+)
+
+// Fixed length errors
+var (
+	ErrFixedLengthWithoutMinLength = errors.New("fixed length requires a minimum length")
+	ErrFixedLengthExceeded         = errors.New("numbers do not fit in the fixed length")
 )
 
 // Options for a custom instance of Sqids
+//
+// Options can be loaded from JSON, see MarshalJSON for the format.
 type Options struct {
-	Alphabet  string
-	MinLength uint8
-	Blocklist []string
+	Alphabet  string   `json:"alphabet,omitempty" yaml:"alphabet,omitempty"`
+	MinLength uint8    `json:"minLength,omitempty" yaml:"minLength,omitempty"`
+	Blocklist []string `json:"blocklist" yaml:"blocklist"`
+
+	// FixedLength makes MinLength the exact length of every ID,
+	// encoding fails if the numbers do not fit
+	FixedLength bool `json:"fixedLength,omitempty" yaml:"fixedLength,omitempty"`
+
+	// Observer is notified of encodes, decodes and blocklist regenerations
+	Observer Observer `json:"-" yaml:"-"`
+
+	// LogNumbers includes the numbers of an ID when it is logged with log/slog
+	LogNumbers bool `json:"logNumbers,omitempty" yaml:"logNumbers,omitempty"`
+
+	// AllowUnicode allows multibyte characters in the alphabet, such as emoji
+	// or CJK characters. Every rune is a character, so characters made of
+	// several runes (like emoji with modifiers) can not be used. Lengths,
+	// including MinLength, are counted in runes.
+	AllowUnicode bool `json:"allowUnicode,omitempty" yaml:"allowUnicode,omitempty"`
+
+	// CaseInsensitive decodes IDs regardless of their case, for IDs that end
+	// up where case is lost. The alphabet must not contain characters that
+	// differ only by case, and defaults to lowercase letters and digits.
+	CaseInsensitive bool `json:"caseInsensitive,omitempty" yaml:"caseInsensitive,omitempty"`
+
+	// Normalizer cleans up IDs before they are decoded
+	Normalizer *Normalizer `json:"-" yaml:"-"`
+
+	// LeetspeakBlocklist also blocks the variants of blocklist words spelled
+	// with 0 for o, 1 for i or l, 3 for e, 4 for a, 5 for s and 7 for t,
+	// so that a word like "idiot" also blocks "1d10t". Like in the default
+	// blocklist, variants with digits only match at the start or end of an ID.
+	LeetspeakBlocklist bool `json:"leetspeakBlocklist,omitempty" yaml:"leetspeakBlocklist,omitempty"`
+
+	// Allowlist are IDs that are never blocked, such as IDs issued before
+	// a word was added to the blocklist
+	Allowlist []string `json:"allowlist,omitempty" yaml:"allowlist,omitempty"`
+
+	// AllowlistNumbers are numbers whose IDs are never blocked
+	AllowlistNumbers [][]uint64 `json:"allowlistNumbers,omitempty" yaml:"allowlistNumbers,omitempty"`
 }
 
 // Sqids lets you generate unique IDs from numbers
 type Sqids struct {
-	alphabet  string
-	minLength uint8
-	blocklist []string
+	alphabet    string
+	minLength   uint8
+	blocklist   []string
+	fixedLength bool
+	observer    Observer
+	logNumbers  bool
+
+	// caseFold maps the lowercase form of every character in the
+	// alphabet back to the character, for case-insensitive decoding
+	caseFold map[rune]rune
+
+	normalizer *Normalizer
+
+	leetspeak bool
+
+	// allowlist and allowlistNumbers are the IDs and numbers (see numbersKey)
+	// that are never blocked
+	allowlist        map[string]bool
+	allowlistNumbers map[string]bool
+
+	// options are the validated options, returned by Options
+	options Options
 }
 
 // New constructs an instance of Sqids
@@ -51,40 +129,130 @@ func New(options ...Options) (*Sqids, error) {
 		return nil, err
 	}
 
-	return &Sqids{
-		alphabet:  shuffle(o.Alphabet),
-		minLength: o.MinLength,
-		blocklist: o.Blocklist,
-	}, nil
-}
+	s := &Sqids{
+		alphabet:    shuffle(o.Alphabet),
+		minLength:   o.MinLength,
+		blocklist:   o.Blocklist,
+		fixedLength: o.FixedLength,
+		observer:    o.Observer,
+		logNumbers:  o.LogNumbers,
+		normalizer:  o.Normalizer,
+		leetspeak:   o.LeetspeakBlocklist,
+		options:     o,
+	}
 
-func validatedOptions(o Options) (Options, error) {
-	if o.Alphabet == "" {
-		o.Alphabet = defaultAlphabet
+	if len(o.Allowlist) > 0 {
+		s.allowlist = make(map[string]bool)
+
+		for _, id := range o.Allowlist {
+			s.allowlist[id] = true
+		}
 	}
 
+	if len(o.AllowlistNumbers) > 0 {
+		s.allowlistNumbers = make(map[string]bool)
+
+		for _, numbers := range o.AllowlistNumbers {
+			s.allowlistNumbers[numbersKey(numbers)] = true
+		}
+	}
+
+	if o.CaseInsensitive {
+		s.caseFold = make(map[rune]rune)
+
+		for _, r := range o.Alphabet {
+			s.caseFold[unicode.ToLower(r)] = r
+		}
+	}
+
+	return s, nil
+}
+
+// Validate reports every problem with the options at once.
+// The returned errors wrap the exported errors, see errors.Is.
+func (o Options) Validate() error {
+	var (
+		errs     []error
+		alphabet = o.alphabet()
+	)
+
 	// check that the alphabet does not contain multibyte characters
-	if len(o.Alphabet) != len([]rune(o.Alphabet)) {
-		return Options{}, errAlphabetMultibyte
+	if chars := multibyteChars(alphabet); len(chars) > 0 && !o.AllowUnicode {
+		errs = append(errs, fmt.Errorf("%w: %q", ErrAlphabetMultibyte, string(chars)))
 	}
 
 	// check the length of the alphabet
-	if len(o.Alphabet) < minAlphabetLength {
-		return Options{}, errAlphabetTooShort
+	if n := len([]rune(alphabet)); n < minAlphabetLength {
+		errs = append(errs, fmt.Errorf("%w, got %d", ErrAlphabetTooShort, n))
 	}
 
 	// check that the alphabet has only unique characters
-	if !hasUniqueChars(o.Alphabet) {
-		return Options{}, errAlphabetNotUniqueChars
+	if chars := duplicateChars(alphabet); len(chars) > 0 {
+		errs = append(errs, fmt.Errorf("%w, duplicated: %q", ErrAlphabetNotUniqueChars, string(chars)))
 	}
 
-	o.Blocklist = filterBlocklist(o.Alphabet, o.Blocklist)
+	// check that a case-insensitive alphabet has no characters that differ only by case
+	if o.CaseInsensitive {
+		if chars := caseConflictChars(alphabet); len(chars) > 0 {
+			errs = append(errs, fmt.Errorf("%w: %q", ErrAlphabetCaseConflict, string(chars)))
+		}
+	}
+
+	// check that a fixed length has a length to be fixed to
+	if o.FixedLength && o.MinLength == 0 {
+		errs = append(errs, ErrFixedLengthWithoutMinLength)
+	}
+
+	return errors.Join(errs...)
+}
+
+// alphabet returns the alphabet, or the default alphabet if none was given
+func (o Options) alphabet() string {
+	switch {
+	case o.Alphabet != "":
+		return o.Alphabet
+	case o.CaseInsensitive:
+		return defaultCaseInsensitiveAlphabet
+	default:
+		return defaultAlphabet
+	}
+}
+
+func validatedOptions(o Options) (Options, error) {
+	if err := o.Validate(); err != nil {
+		return Options{}, err
+	}
+
+	o.Alphabet = o.alphabet()
+	if o.LeetspeakBlocklist {
+		o.Blocklist = filterLeetspeakBlocklist(o.Alphabet, o.Blocklist)
+	} else {
+		o.Blocklist = filterBlocklist(o.Alphabet, o.Blocklist)
+	}
 
 	return o, nil
 }
 
 // Encode a slice of uint64 values into an ID string
 func (s *Sqids) Encode(numbers []uint64) (string, error) {
+	if s.observer == nil {
+		return s.encode(numbers)
+	}
+
+	start := time.Now()
+	id, err := s.encode(numbers)
+
+	s.observer.OnEncode(EncodeEvent{
+		Numbers:  numbers,
+		ID:       id,
+		Err:      err,
+		Duration: time.Since(start),
+	})
+
+	return id, err
+}
+
+func (s *Sqids) encode(numbers []uint64) (string, error) {
 	// if no numbers passed, return an empty string
 	if len(numbers) == 0 {
 		return "", nil
@@ -94,8 +262,15 @@ func (s *Sqids) Encode(numbers []uint64) (string, error) {
 }
 
 func (s *Sqids) encodeNumbers(numbers []uint64, increment int) (string, error) {
-	if increment > len(s.alphabet) {
-		return "", errMaxRegenerationAttempts
+	if increment > utf8.RuneCountInString(s.alphabet) {
+		return "", ErrMaxRegenerationAttempts
+	}
+
+	if increment > 0 && s.observer != nil {
+		s.observer.OnRegenerate(RegenerateEvent{
+			Numbers:   numbers,
+			Increment: increment,
+		})
 	}
 
 	var (
@@ -117,18 +292,30 @@ func (s *Sqids) encodeNumbers(numbers []uint64, increment int) (string, error) {
 		}
 	}
 
-	id := string(ret)
-
-	if int(s.minLength) > len(id) {
-		id += string(alphabet[0])
+	if int(s.minLength) > len(ret) {
+		ret = append(ret, alphabet[0])
 
-		for int(s.minLength)-len(id) > 0 {
+		for int(s.minLength)-len(ret) > 0 {
 			alphabet = []rune(shuffle(string(alphabet)))
-			id += string(alphabet[:min(int(s.minLength)-len(id), len(alphabet))])
+			ret = append(ret, alphabet[:min(int(s.minLength)-len(ret), len(alphabet))]...)
 		}
 	}
 
-	if s.isBlockedID(id) {
+	if s.fixedLength && len(ret) > int(s.minLength) {
+		return "", ErrFixedLengthExceeded
+	}
+
+	id := string(ret)
+
+	if word, _, ok := s.BlockedBy(id); ok {
+		if s.observer != nil {
+			s.observer.OnBlocklistHit(BlocklistHitEvent{
+				ID:        id,
+				Word:      word,
+				Increment: increment,
+			})
+		}
+
 		id, err = s.encodeNumbers(numbers, increment+1)
 		if err != nil {
 			return "", err
@@ -140,29 +327,77 @@ func (s *Sqids) encodeNumbers(numbers []uint64, increment int) (string, error) {
 
 // Decode id string into a slice of uint64 values
 func (s *Sqids) Decode(id string) []uint64 {
+	ret, _ := s.decodeObserved(id, nil)
+
+	return ret
+}
+
+// decodeObserved is decode for the public decoding methods, which notify
+// the observer (if any)
+func (s *Sqids) decodeObserved(id string, in *Inspection) ([]uint64, error) {
+	if s.observer == nil {
+		return s.decode(id, in)
+	}
+
+	start := time.Now()
+	ret, err := s.decode(id, in)
+
+	s.observer.OnDecode(DecodeEvent{
+		ID:       id,
+		Numbers:  ret,
+		Err:      err,
+		Duration: time.Since(start),
+	})
+
+	return ret, err
+}
+
+// decode id string into a slice of uint64 values, recording how
+// the id was taken apart in the given inspection (if any)
+func (s *Sqids) decode(id string, in *Inspection) ([]uint64, error) {
 	ret := []uint64{}
 
+	id = s.Normalize(id)
+
 	if id == "" {
-		return ret
+		return ret, nil
 	}
 
 	rid := []rune(id)
 
+	if s.caseFold != nil {
+		for i, r := range rid {
+			if c, ok := s.caseFold[unicode.ToLower(r)]; ok {
+				rid[i] = c
+			}
+		}
+	}
+
+	if in != nil {
+		in.Normalized = string(rid)
+	}
+
 	alphabet := []rune(s.alphabet)
 
 	for _, r := range rid {
 		if !contains(alphabet, r) {
-			return ret
+			return ret, ErrIDInvalidCharacter
 		}
 	}
 
 	prefix := rid[0]
 	offset := index(alphabet, prefix)
 
+	if in != nil {
+		in.Prefix = prefix
+		in.Offset = offset
+	}
+
 	alphabet = alphabetOffset(s.alphabet, offset)
 	alphabet = reverseRunes(alphabet)
 
 	rid = rid[1:]
+	pos := 1
 
 	for len(rid) > 0 {
 		separator := alphabet[0]
@@ -170,11 +405,22 @@ func (s *Sqids) Decode(id string) []uint64 {
 		chunks := splitChunks(rid, separator)
 		if len(chunks) > 0 {
 			if len(chunks[0]) == 0 {
-				return ret
+				return ret, nil
 			}
 
 			ret = append(ret, toNumber(chunks[0], alphabet[1:]))
 
+			if in != nil {
+				in.Chunks = append(in.Chunks, Chunk{
+					Start:     pos,
+					Value:     string(chunks[0]),
+					Number:    ret[len(ret)-1],
+					Separator: separator,
+				})
+			}
+
+			pos += len(chunks[0]) + 1
+
 			if len(chunks) > 1 {
 				alphabet = shuffleRunes(alphabet)
 			}
@@ -183,11 +429,11 @@ func (s *Sqids) Decode(id string) []uint64 {
 		if len(chunks) > 0 {
 			rid = joinRuneSlices(chunks[1:], separator)
 		} else {
-			return []uint64{}
+			return []uint64{}, nil
 		}
 	}
 
-	return ret
+	return ret, nil
 }
 
 func alphabetOffset(alphabet string, offset int) []rune {
@@ -229,27 +475,82 @@ func splitChunks(runes []rune, separator rune) [][]rune {
 }
 
 func (s *Sqids) isBlockedID(id string) bool {
+	_, _, ok := s.BlockedBy(id)
+
+	return ok
+}
+
+// BlockedBy returns the first blocklist word that blocks the id, and the
+// rule that matched it: BlockRuleExact for IDs or words of 3 characters
+// or less, BlockRulePrefix or BlockRuleSuffix for words with digits,
+// and BlockRuleSubstring otherwise. IDs in the allowlist are never blocked.
+func (s *Sqids) BlockedBy(id string) (word string, rule string, ok bool) {
+	if s.isAllowedID(id) {
+		return "", "", false
+	}
+
 	id = strings.ToLower(id)
 
+	if s.leetspeak {
+		return leetspeakBlockedBy(id, s.blocklist)
+	}
+
+	idLength := utf8.RuneCountInString(id)
+
 	for _, word := range s.blocklist {
-		if len(word) <= len(id) {
-			if len(id) <= 3 || len(word) <= 3 {
+		if wordLength := utf8.RuneCountInString(word); wordLength <= idLength {
+			if idLength <= 3 || wordLength <= 3 {
 				if id == word {
-					return true
+					return word, BlockRuleExact, true
 				}
 			} else if hasDigit(word) {
-				if strings.HasPrefix(id, word) || strings.HasSuffix(id, word) {
-					return true
+				if strings.HasPrefix(id, word) {
+					return word, BlockRulePrefix, true
+				}
+
+				if strings.HasSuffix(id, word) {
+					return word, BlockRuleSuffix, true
 				}
 			} else if strings.Contains(id, word) {
-				return true
+				return word, BlockRuleSubstring, true
 			}
 		}
 	}
 
+	return "", "", false
+}
+
+// isAllowedID reports whether the id, or the numbers it decodes to,
+// are in the allowlist
+func (s *Sqids) isAllowedID(id string) bool {
+	if s.allowlist[id] {
+		return true
+	}
+
+	if s.allowlistNumbers != nil {
+		numbers, err := s.decode(id, nil)
+
+		return err == nil && s.allowlistNumbers[numbersKey(numbers)]
+	}
+
 	return false
 }
 
+// numbersKey returns a map key for the numbers
+func numbersKey(numbers []uint64) string {
+	var b strings.Builder
+
+	for i, n := range numbers {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		b.WriteString(strconv.FormatUint(n, 10))
+	}
+
+	return b.String()
+}
+
 func calculateOffset(alphabet string, numbers []uint64, increment int) int {
 	var (
 		offset = len(numbers)
@@ -335,15 +636,54 @@ func index(s []rune, r rune) int {
 	return -1
 }
 
-func hasUniqueChars(str string) bool {
-	charSet := make(map[rune]bool)
+// duplicateChars returns the characters that occur more than once in str
+func duplicateChars(str string) []rune {
+	var (
+		dups    []rune
+		charSet = make(map[rune]int)
+	)
+
 	for _, c := range str {
-		if _, ok := charSet[c]; ok {
-			return false
+		if charSet[c]++; charSet[c] == 2 {
+			dups = append(dups, c)
 		}
-		charSet[c] = true
 	}
-	return true
+
+	return dups
+}
+
+// caseConflictChars returns the characters in str whose lowercase form
+// is shared with an earlier character
+func caseConflictChars(str string) []rune {
+	var (
+		chars   []rune
+		charSet = make(map[rune]rune)
+	)
+
+	for _, c := range str {
+		lower := unicode.ToLower(c)
+
+		if prev, ok := charSet[lower]; ok && prev != c {
+			chars = append(chars, c)
+		} else {
+			charSet[lower] = c
+		}
+	}
+
+	return chars
+}
+
+// multibyteChars returns the characters in str that are not a single byte
+func multibyteChars(str string) []rune {
+	var chars []rune
+
+	for _, c := range str {
+		if utf8.RuneLen(c) != 1 {
+			chars = append(chars, c)
+		}
+	}
+
+	return chars
 }
 
 func contains(s []rune, r rune) bool {
@@ -365,10 +705,3 @@ func hasDigit(word string) bool {
 
 	return false
 }
-
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
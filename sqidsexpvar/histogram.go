@@ -0,0 +1,74 @@
+package sqidsexpvar
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// bounds are the upper bounds of the latency buckets
+var bounds = []time.Duration{
+	250 * time.Nanosecond,
+	500 * time.Nanosecond,
+	time.Microsecond,
+	2500 * time.Nanosecond,
+	5 * time.Microsecond,
+	10 * time.Microsecond,
+	25 * time.Microsecond,
+	50 * time.Microsecond,
+	100 * time.Microsecond,
+	time.Millisecond,
+}
+
+// histogram is an expvar.Var counting durations in fixed buckets
+type histogram struct {
+	count   atomic.Uint64
+	sum     atomic.Int64
+	buckets []atomic.Uint64
+}
+
+func newHistogram() *histogram {
+	return &histogram{
+		// the last bucket counts everything above the last bound
+		buckets: make([]atomic.Uint64, len(bounds)+1),
+	}
+}
+
+func (h *histogram) observe(d time.Duration) {
+	h.count.Add(1)
+	h.sum.Add(int64(d))
+
+	i := 0
+	for i < len(bounds) && d > bounds[i] {
+		i++
+	}
+
+	h.buckets[i].Add(1)
+}
+
+// String returns the histogram as JSON, with buckets keyed by their upper bound
+func (h *histogram) String() string {
+	buckets := make(map[string]uint64, len(h.buckets))
+
+	for i := range h.buckets {
+		key := "+Inf"
+		if i < len(bounds) {
+			key = strconv.FormatInt(bounds[i].Nanoseconds(), 10)
+		}
+
+		buckets[key] = h.buckets[i].Load()
+	}
+
+	b, _ := json.Marshal(struct {
+		Count   uint64            `json:"count"`
+		SumNs   int64             `json:"sum_ns"`
+		Buckets map[string]uint64 `json:"buckets_ns"`
+	}{
+		Count:   h.count.Load(),
+		SumNs:   h.sum.Load(),
+		Buckets: buckets,
+	})
+
+	return string(b)
+}
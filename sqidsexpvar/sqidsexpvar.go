@@ -0,0 +1,117 @@
+// Package sqidsexpvar publishes Sqids metrics with expvar.
+//
+// An Observer is set as the Observer of one or more Sqids instances,
+// and the totals show up in the /debug/vars endpoint:
+//
+//	o := sqidsexpvar.Publish("sqids")
+//	s, _ := sqids.New(sqids.Options{Observer: o})
+//
+// The runtime/metrics package only reports metrics defined by the runtime
+// itself, so expvar is the only integration provided.
+package sqidsexpvar
+
+import (
+	"expvar"
+	"sync"
+
+	"github.com/sqids/sqids-go"
+)
+
+// Observer is a sqids.Observer that records metrics in an expvar.Map
+type Observer struct {
+	vars *expvar.Map
+
+	encodes       *expvar.Int
+	encodeErrors  *expvar.Map
+	encodeLatency *histogram
+	decodes       *expvar.Int
+	decodeErrors  *expvar.Map
+	decodeLatency *histogram
+	blocklistHits *expvar.Map
+	regenerations *expvar.Int
+}
+
+var (
+	defaultOnce     sync.Once
+	defaultObserver *Observer
+)
+
+// Default returns an Observer published under the name "sqids"
+func Default() *Observer {
+	defaultOnce.Do(func() {
+		defaultObserver = Publish("sqids")
+	})
+
+	return defaultObserver
+}
+
+// Publish returns an Observer whose metrics are published under name.
+// Like expvar.Publish it panics if name is already in use.
+func Publish(name string) *Observer {
+	o := New()
+
+	expvar.Publish(name, o.vars)
+
+	return o
+}
+
+// New returns an Observer that is not published, see Observer.Var
+func New() *Observer {
+	o := &Observer{
+		vars:          new(expvar.Map),
+		encodes:       new(expvar.Int),
+		encodeErrors:  new(expvar.Map),
+		encodeLatency: newHistogram(),
+		decodes:       new(expvar.Int),
+		decodeErrors:  new(expvar.Map),
+		decodeLatency: newHistogram(),
+		blocklistHits: new(expvar.Map),
+		regenerations: new(expvar.Int),
+	}
+
+	o.vars.Set("encodes", o.encodes)
+	o.vars.Set("encode_errors", o.encodeErrors)
+	o.vars.Set("encode_latency", o.encodeLatency)
+	o.vars.Set("decodes", o.decodes)
+	o.vars.Set("decode_errors", o.decodeErrors)
+	o.vars.Set("decode_latency", o.decodeLatency)
+	o.vars.Set("blocklist_hits", o.blocklistHits)
+	o.vars.Set("regenerations", o.regenerations)
+
+	return o
+}
+
+// Var returns the expvar.Map holding the metrics
+func (o *Observer) Var() *expvar.Map {
+	return o.vars
+}
+
+// OnEncode records an encode, its latency and its error (if any)
+func (o *Observer) OnEncode(e sqids.EncodeEvent) {
+	o.encodes.Add(1)
+	o.encodeLatency.observe(e.Duration)
+
+	if e.Err != nil {
+		o.encodeErrors.Add(e.Err.Error(), 1)
+	}
+}
+
+// OnDecode records a decode, its latency and its error (if any)
+func (o *Observer) OnDecode(e sqids.DecodeEvent) {
+	o.decodes.Add(1)
+	o.decodeLatency.observe(e.Duration)
+
+	if e.Err != nil {
+		o.decodeErrors.Add(e.Err.Error(), 1)
+	}
+}
+
+// OnBlocklistHit records the blocklist word that was hit
+func (o *Observer) OnBlocklistHit(e sqids.BlocklistHitEvent) {
+	o.blocklistHits.Add(e.Word, 1)
+}
+
+// OnRegenerate records a regeneration
+func (o *Observer) OnRegenerate(sqids.RegenerateEvent) {
+	o.regenerations.Add(1)
+}
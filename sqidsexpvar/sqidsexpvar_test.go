@@ -0,0 +1,75 @@
+package sqidsexpvar
+
+import (
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"testing"
+
+	"github.com/sqids/sqids-go"
+)
+
+// runs numbers the test runs, since expvar names can be published once
+// per process and tests may run more than once with -count
+var runs int
+
+func TestPublish(t *testing.T) {
+	runs++
+	name := fmt.Sprintf("sqids_test_%d", runs)
+
+	o := Publish(name)
+
+	if expvar.Get(name) != o.Var() {
+		t.Errorf("Publish(%q) should publish the observer's map", name)
+	}
+}
+
+func TestObserver(t *testing.T) {
+	o := New()
+
+	s, err := sqids.New(sqids.Options{
+		Blocklist: []string{"86Rf07"},
+		Observer:  o,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Encode([]uint64{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	s.Decode("se8ojk")
+	s.Decode("se8ojk!")
+
+	var vars struct {
+		Encodes       int64            `json:"encodes"`
+		Decodes       int64            `json:"decodes"`
+		DecodeErrors  map[string]int64 `json:"decode_errors"`
+		BlocklistHits map[string]int64 `json:"blocklist_hits"`
+		Regenerations int64            `json:"regenerations"`
+		DecodeLatency struct {
+			Count uint64 `json:"count"`
+		} `json:"decode_latency"`
+	}
+
+	if err := json.Unmarshal([]byte(o.Var().String()), &vars); err != nil {
+		t.Fatal(err)
+	}
+
+	if vars.Encodes != 1 || vars.Decodes != 2 || vars.Regenerations != 1 {
+		t.Errorf("unexpected totals: %+v", vars)
+	}
+
+	if len(vars.DecodeErrors) != 1 {
+		t.Errorf("decode errors = %v, want one kind", vars.DecodeErrors)
+	}
+
+	if vars.BlocklistHits["86rf07"] != 1 {
+		t.Errorf("blocklist hits = %v, want 86rf07 once", vars.BlocklistHits)
+	}
+
+	if vars.DecodeLatency.Count != 2 {
+		t.Errorf("decode latency count = %d, want 2", vars.DecodeLatency.Count)
+	}
+}
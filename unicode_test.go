@@ -0,0 +1,150 @@
+package sqids
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+const emojiAlphabet = "😀😁😂🤣😃😄😅😆😉😊😋😎😍😘🥰😗😙🥲😚🙂🤗🤩🤔🫡🤨😐😑😶🫥🙄😏😣😥😮🤐😯😪😫🥱😴😌😛😜😝🤤😒😓😔😕🫤🙃🫠🤑😲"
+
+func TestUnicodeAlphabetNotAllowed(t *testing.T) {
+	if _, err := New(Options{Alphabet: emojiAlphabet}); !errors.Is(err, ErrAlphabetMultibyte) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestUnicodeAlphabet(t *testing.T) {
+	for _, alphabet := range []string{emojiAlphabet, "零一二三四五六七八九十百千万亿", "ëabcdef"} {
+		s, err := New(Options{
+			Alphabet:     alphabet,
+			AllowUnicode: true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, numbers := range [][]uint64{
+			{minUint64Value},
+			{0, 0, 0, 0, 0},
+			{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			{100, 200, 300},
+			{1000000},
+			{maxUint64Value},
+		} {
+			generatedID, err := s.Encode(numbers)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := utf8.RuneCountInString(generatedID), s.EncodedLength(numbers); got != want {
+				t.Errorf("Encoding `%v` produced `%v` runes, want `%v`", numbers, got, want)
+			}
+
+			decodedNumbers := s.Decode(generatedID)
+			if !reflect.DeepEqual(numbers, decodedNumbers) {
+				t.Errorf("Decoding `%v` should produce `%v`, but instead produced `%v`", generatedID, numbers, decodedNumbers)
+			}
+		}
+	}
+}
+
+func TestUnicodeMinLength(t *testing.T) {
+	for _, minLength := range []uint8{1, 5, 10, uint8(utf8.RuneCountInString(emojiAlphabet)) + 3} {
+		s, err := New(Options{
+			Alphabet:     emojiAlphabet,
+			AllowUnicode: true,
+			MinLength:    minLength,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		numbers := []uint64{1, 2, 3}
+
+		generatedID, err := s.Encode(numbers)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := utf8.RuneCountInString(generatedID); got < int(minLength) {
+			t.Errorf("Encoding `%v` with min length `%v` produced `%v` runes", numbers, minLength, got)
+		}
+
+		decodedNumbers := s.Decode(generatedID)
+		if !reflect.DeepEqual(numbers, decodedNumbers) {
+			t.Errorf("Decoding `%v` should produce `%v`, but instead produced `%v`", generatedID, numbers, decodedNumbers)
+		}
+	}
+}
+
+func TestUnicodeFixedLength(t *testing.T) {
+	s, err := New(Options{
+		Alphabet:     emojiAlphabet,
+		AllowUnicode: true,
+		MinLength:    8,
+		FixedLength:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	generatedID, err := s.Encode([]uint64{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := utf8.RuneCountInString(generatedID); got != 8 {
+		t.Errorf("Encoding produced `%v` runes, want `8`", got)
+	}
+}
+
+func TestUnicodeBlocklist(t *testing.T) {
+	alphabet := "абвгдежзийклмнопрстуфхцчшщ"
+
+	s, err := New(Options{
+		Alphabet:     alphabet,
+		AllowUnicode: true,
+		Blocklist:    []string{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	numbers := []uint64{1, 2, 3}
+
+	id, err := s.Encode(numbers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the blocklist is lowercased, and words are counted in runes
+	blocked, err := New(Options{
+		Alphabet:     alphabet,
+		AllowUnicode: true,
+		Blocklist:    []string{"АБ", strings.ToUpper(id)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := blocked.blocklist; !reflect.DeepEqual(got, []string{id}) {
+		t.Errorf("filtered blocklist = %v, want [%v]", got, id)
+	}
+
+	generatedID, err := blocked.Encode(numbers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if generatedID == id {
+		t.Errorf("Encoding `%v` should not produce the blocked `%v`", numbers, id)
+	}
+
+	decodedNumbers := blocked.Decode(generatedID)
+	if !reflect.DeepEqual(numbers, decodedNumbers) {
+		t.Errorf("Decoding `%v` should produce `%v`, but instead produced `%v`", generatedID, numbers, decodedNumbers)
+	}
+}